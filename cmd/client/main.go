@@ -14,6 +14,8 @@ import (
 	"github.com/skushnerchuk/simda/internal/clientui"
 	"github.com/skushnerchuk/simda/internal/clientui/splash"
 	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	"github.com/skushnerchuk/simda/internal/query"
+	"github.com/skushnerchuk/simda/internal/recording"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -24,21 +26,36 @@ const ClientVersion = "0.0.1"
 var (
 	receive uint
 	warm    uint
+	resync  uint
 	server  string
 	port    string
+	record  string
+	queries []string
 )
 
 const maxWarm = 120
 
 var App *tview.Application
 
-func validateParams() {
+func validateParams() []*pb.Query {
 	if warm > maxWarm {
 		fatal("warm cannot be greater than %d seconds\n", maxWarm)
 	}
 	if warm < receive {
 		fatal("warm cannot be less than receive\n")
 	}
+	result := make([]*pb.Query, 0, len(queries))
+	for _, s := range queries {
+		q, err := query.Parse(s)
+		if err != nil {
+			fatal("%s\n", err.Error())
+		}
+		result = append(result, q)
+	}
+	if _, err := query.New(result); err != nil {
+		fatal("%s\n", err.Error())
+	}
+	return result
 }
 
 var rootCmd = &cobra.Command{
@@ -46,10 +63,11 @@ var rootCmd = &cobra.Command{
 	Short:   "System Information Monitoring DAemon client",
 	Version: ClientVersion,
 	Run: func(_ *cobra.Command, _ []string) {
-		validateParams()
+		requestQueries := validateParams()
 
 		App = tview.NewApplication()
 		mainWindow := clientui.NewMainView(server, port, int(warm), int(receive))
+		mainWindow.SetQueries(requestQueries)
 		warmWindow := splash.NewWarmingWindow(int(warm))
 
 		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
@@ -59,7 +77,16 @@ var rootCmd = &cobra.Command{
 		ch := make(chan *pb.Snapshot)
 		defer close(ch)
 
-		c := client.NewClient(warm, receive, server, port)
+		var recorder *recording.Writer
+		if record != "" {
+			var err error
+			if recorder, err = recording.Create(record); err != nil {
+				fatal("%s\n", err.Error())
+			}
+			defer recorder.Close()
+		}
+
+		c := client.NewClient(warm, receive, resync, server, port, recorder, requestQueries)
 
 		App.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 			switch event.Key() { //nolint:exhaustive
@@ -135,8 +162,16 @@ func init() {
 
 	rootCmd.Flags().UintVarP(&receive, "receive", "r", 5, "receive snapshots every N seconds")
 	rootCmd.Flags().UintVarP(&warm, "warm", "w", 5, "warm up time in seconds")
+	rootCmd.Flags().UintVar(
+		&resync, "delta-resync", 0, "receive only list changes, full snapshot every N messages (0 - disabled)",
+	)
 	rootCmd.Flags().StringVarP(&server, "server", "s", "127.0.0.1", "server ip")
 	rootCmd.Flags().StringVarP(&port, "port", "p", "50051", "server port")
+	rootCmd.Flags().StringArrayVarP(
+		&queries, "query", "q", nil,
+		"server-side list query: target;filter=<conditions>;order=<field>;desc;limit=<N> (repeatable)",
+	)
+	rootCmd.Flags().StringVar(&record, "record", "", "write received snapshots to file for simda replay")
 }
 
 func main() {
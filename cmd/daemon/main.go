@@ -1,21 +1,28 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/skushnerchuk/simda/internal/config"
 	"github.com/skushnerchuk/simda/internal/daemon"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/replay"
 	"github.com/skushnerchuk/simda/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	dmnConfig  *config.DaemonConfig
-	configFile string
+	dmnConfig   *config.DaemonConfig
+	configFile  string
+	replaySpeed float64
+	replayLoop  bool
 )
 
 const DaemonVersion = "0.0.1"
@@ -40,6 +47,30 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// replayCmd отдает записанный клиентом поток снимков по адресу демона из настроек,
+// обычный клиент подключается к нему как к демону.
+var replayCmd = &cobra.Command{
+	Use:   "replay <file>",
+	Short: "Serve a recorded snapshot stream as a fake daemon",
+	Args:  cobra.ExactArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		if replaySpeed <= 0 {
+			fatal("speed must be greater than 0\n")
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+		defer cancel()
+
+		l := logger.NewSLogger(os.Stdout, dmnConfig.LogLevel)
+		srv := replay.NewServer(args[0], dmnConfig.Host+":"+dmnConfig.Port, replaySpeed, replayLoop, l)
+		if err := srv.Start(ctx); err != nil {
+			fatal("%s\n", err.Error())
+		}
+		<-ctx.Done()
+		srv.Stop()
+	},
+}
+
 func fatal(msg string, args ...any) {
 	fmt.Printf(msg, args...)
 	os.Exit(1)
@@ -69,13 +100,17 @@ func initConfig() {
 func init() {
 	cobra.OnInitialize(initConfig)
 
-	rootCmd.Flags().StringVarP(
+	rootCmd.PersistentFlags().StringVarP(
 		&configFile,
 		"config",
 		"c",
 		"/etc/simda/config.yml",
 		"Path to configuration file",
 	)
+
+	replayCmd.Flags().Float64Var(&replaySpeed, "speed", 1, "playback speed, 2 - twice as fast as recorded")
+	replayCmd.Flags().BoolVar(&replayLoop, "loop", false, "restart the recording when it ends")
+	rootCmd.AddCommand(replayCmd)
 }
 
 func main() {
@@ -0,0 +1,174 @@
+package alert
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Состояния оповещения.
+const (
+	StatePending  = "pending"
+	StateFiring   = "firing"
+	StateResolved = "resolved"
+)
+
+// resolvedRetention - время, в течение которого разрешенное оповещение передается клиентам,
+// чтобы клиент с большим периодом снимков не пропустил его.
+const resolvedRetention = 10 * time.Minute
+
+// Alert - оповещение по одному временному ряду правила. Время - Unix-время в миллисекундах,
+// 0 - событие не наступило.
+type Alert struct {
+	Rule        string            `json:"rule"`
+	Expr        string            `json:"expr"`
+	Severity    string            `json:"severity"`
+	State       string            `json:"state"`
+	Labels      map[string]string `json:"labels"`
+	Value       float64           `json:"value"`
+	Threshold   float64           `json:"threshold"`
+	ActiveSince int64             `json:"activeSince"`
+	FiredAt     int64             `json:"firedAt"`
+	ResolvedAt  int64             `json:"resolvedAt"`
+}
+
+func (a *Alert) proto() *pb.Alert {
+	return &pb.Alert{
+		Rule:        a.Rule,
+		Expr:        a.Expr,
+		Severity:    a.Severity,
+		State:       a.State,
+		Labels:      a.Labels,
+		Value:       a.Value,
+		Threshold:   a.Threshold,
+		ActiveSince: a.ActiveSince,
+		FiredAt:     a.FiredAt,
+		ResolvedAt:  a.ResolvedAt,
+	}
+}
+
+// Engine проверяет правила по снимкам и хранит состояние оповещений. Методы безопасны
+// для вызова из нескольких горутин.
+type Engine struct {
+	rules    []*Rule
+	mu       sync.Mutex
+	active   map[string]*Alert
+	resolved []*Alert
+}
+
+// NewEngine разбирает правила из настроек.
+func NewEngine(rules []config.AlertRule) (*Engine, error) {
+	result := &Engine{active: make(map[string]*Alert)}
+	for _, r := range rules {
+		rule, err := ParseRule(r)
+		if err != nil {
+			return nil, err
+		}
+		result.rules = append(result.rules, rule)
+	}
+	return result, nil
+}
+
+// Collectors возвращает имена сборщиков, метрики которых используются в правилах.
+func (e *Engine) Collectors() []string {
+	seen := make(map[string]bool, len(e.rules))
+	result := make([]string, 0, len(e.rules))
+	for _, r := range e.rules {
+		if name := r.Collector(); !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// Evaluate проверяет правила по снимку, построенному в момент now, и возвращает оповещения,
+// которые сработали или были разрешены. Условие, которое выполняется меньше времени For правила,
+// переводит оповещение в состояние pending. Оповещение разрешается, если условие перестало
+// выполняться или временной ряд пропал из снимка.
+func (e *Engine) Evaluate(snapshot *pb.Snapshot, now time.Time) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var changes []Alert
+	ms := now.UnixMilli()
+	seen := make(map[string]bool, len(e.active))
+	for _, r := range e.rules {
+		for _, p := range r.points(snapshot) {
+			if !r.check(p.value) {
+				continue
+			}
+			k := key(r.Name, p.labels)
+			seen[k] = true
+			a, ok := e.active[k]
+			if !ok {
+				a = &Alert{
+					Rule:        r.Name,
+					Expr:        r.Expr,
+					Severity:    r.Severity,
+					State:       StatePending,
+					Labels:      p.labels,
+					Threshold:   r.threshold,
+					ActiveSince: ms,
+				}
+				e.active[k] = a
+			}
+			a.Value = p.value
+			if a.State == StatePending && now.Sub(time.UnixMilli(a.ActiveSince)) >= r.For {
+				a.State = StateFiring
+				a.FiredAt = ms
+				changes = append(changes, *a)
+			}
+		}
+	}
+	for k, a := range e.active {
+		if seen[k] {
+			continue
+		}
+		delete(e.active, k)
+		if a.State == StateFiring {
+			a.State = StateResolved
+			a.ResolvedAt = ms
+			e.resolved = append(e.resolved, a)
+			changes = append(changes, *a)
+		}
+	}
+	e.trimResolved(now)
+	return changes
+}
+
+func (e *Engine) trimResolved(now time.Time) {
+	n := 0
+	for n < len(e.resolved) && now.Sub(time.UnixMilli(e.resolved[n].ResolvedAt)) > resolvedRetention {
+		n++
+	}
+	e.resolved = e.resolved[n:]
+}
+
+// Alerts возвращает активные оповещения и оповещения, разрешенные после since,
+// упорядоченные по имени правила и меткам.
+func (e *Engine) Alerts(since time.Time) []*pb.Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	items := make([]*Alert, 0, len(e.active))
+	for _, a := range e.active {
+		items = append(items, a)
+	}
+	for _, a := range e.resolved {
+		if a.ResolvedAt > since.UnixMilli() {
+			items = append(items, a)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(items[i].Rule, items[i].Labels) < key(items[j].Rule, items[j].Labels)
+	})
+	result := make([]*pb.Alert, 0, len(items))
+	for _, a := range items {
+		result = append(result, a.proto())
+	}
+	return result
+}
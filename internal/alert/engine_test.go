@@ -0,0 +1,76 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+)
+
+func cpu(user float64) *pb.Snapshot {
+	return &pb.Snapshot{CpuAvg: &pb.CpuAverage{User: user}}
+}
+
+func TestEngine(t *testing.T) {
+	e, err := NewEngine([]config.AlertRule{
+		{Name: "cpu", Expr: "cpu.user > 90 for 20s", Severity: "warning"},
+		{Name: "load", Expr: "load_avg > 4"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"cpu_avg", "load_avg"}, e.Collectors())
+
+	start := time.UnixMilli(time.Now().UnixMilli())
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+
+	// Условие выполняется меньше 20 секунд - оповещение ожидает
+	require.Empty(t, e.Evaluate(cpu(95), at(0)))
+	require.Empty(t, e.Evaluate(cpu(96), at(10)))
+	alerts := e.Alerts(at(0))
+	require.Len(t, alerts, 1)
+	require.Equal(t, StatePending, alerts[0].State)
+	require.Equal(t, 96.0, alerts[0].Value)
+
+	// Условие перестало выполняться до срабатывания - оповещение сбрасывается без уведомления
+	require.Empty(t, e.Evaluate(cpu(50), at(15)))
+	require.Empty(t, e.Alerts(at(0)))
+
+	require.Empty(t, e.Evaluate(cpu(95), at(20)))
+	changes := e.Evaluate(cpu(97), at(40))
+	require.Len(t, changes, 1)
+	require.Equal(t, StateFiring, changes[0].State)
+	require.Equal(t, "warning", changes[0].Severity)
+	require.Equal(t, at(20).UnixMilli(), changes[0].ActiveSince)
+	require.Equal(t, at(40).UnixMilli(), changes[0].FiredAt)
+	require.Empty(t, e.Evaluate(cpu(97), at(50)))
+
+	// Метрика пропала из снимка - оповещение разрешается
+	changes = e.Evaluate(&pb.Snapshot{}, at(60))
+	require.Len(t, changes, 1)
+	require.Equal(t, StateResolved, changes[0].State)
+	require.Equal(t, at(60).UnixMilli(), changes[0].ResolvedAt)
+
+	alerts = e.Alerts(at(50))
+	require.Len(t, alerts, 1)
+	require.Equal(t, StateResolved, alerts[0].State)
+	require.Empty(t, e.Alerts(at(60)))
+
+	// Правило без времени ожидания срабатывает сразу
+	changes = e.Evaluate(&pb.Snapshot{LoadAvg: &pb.LoadAverage{One: 5}}, at(70))
+	require.Len(t, changes, 1)
+	require.Equal(t, "load", changes[0].Rule)
+	require.Equal(t, StateFiring, changes[0].State)
+
+	// Разрешенные оповещения хранятся ограниченное время
+	require.Len(t, e.Evaluate(&pb.Snapshot{}, at(80)), 1)
+	e.Evaluate(&pb.Snapshot{}, at(80).Add(resolvedRetention))
+	require.Len(t, e.Alerts(start), 1)
+	e.Evaluate(&pb.Snapshot{}, at(81).Add(resolvedRetention))
+	require.Empty(t, e.Alerts(start))
+}
+
+func TestEngineInvalidRule(t *testing.T) {
+	_, err := NewEngine([]config.AlertRule{{Name: "bad", Expr: "cpu.user >"}})
+	require.ErrorIs(t, err, ErrInvalidRule)
+}
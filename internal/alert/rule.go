@@ -0,0 +1,153 @@
+package alert
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+var (
+	ErrInvalidRule   = errors.New("invalid alert rule")
+	ErrUnknownMetric = errors.New("unknown metric")
+)
+
+// ruleRe разбирает условие вида metric{label=value,...}.field op threshold [for duration].
+var ruleRe = regexp.MustCompile(
+	`^\s*([a-z_]+)\s*(?:\{([^}]*)\})?\s*(?:\.([a-z_]+))?\s*(>=|<=|==|!=|>|<)\s*(\S+)\s*(?:\s+for\s+(\S+))?\s*$`,
+)
+
+var matcherRe = regexp.MustCompile(`^\s*([a-z_]+)\s*(!=|=)\s*"?(.*?)"?\s*$`)
+
+// matcher - условие на метку временного ряда.
+type matcher struct {
+	label string
+	value string
+	equal bool
+}
+
+func (m matcher) match(labels map[string]string) bool {
+	return (labels[m.label] == m.value) == m.equal
+}
+
+// Rule - разобранное правило оповещения.
+type Rule struct {
+	Name      string
+	Expr      string
+	Severity  string
+	metric    string
+	field     string
+	matchers  []matcher
+	op        string
+	threshold float64
+	// For - время, в течение которого условие должно выполняться, чтобы оповещение сработало
+	For time.Duration
+}
+
+// ParseRule разбирает условие правила. Если поле метрики не указано, используется поле по умолчанию.
+func ParseRule(r config.AlertRule) (*Rule, error) {
+	m := ruleRe.FindStringSubmatch(r.Expr)
+	if m == nil {
+		return nil, fmt.Errorf("%w: %s: %q", ErrInvalidRule, r.Name, r.Expr)
+	}
+	t, ok := targets[m[1]]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: %s", ErrUnknownMetric, r.Name, m[1])
+	}
+	result := &Rule{
+		Name:     r.Name,
+		Expr:     r.Expr,
+		Severity: r.Severity,
+		metric:   m[1],
+		field:    m[3],
+		op:       m[4],
+	}
+	if result.field == "" {
+		result.field = t.field
+	}
+	if !t.has(result.field) {
+		return nil, fmt.Errorf("%w: %s: %s.%s", ErrUnknownMetric, r.Name, m[1], result.field)
+	}
+	if strings.TrimSpace(m[2]) != "" {
+		for _, item := range strings.Split(m[2], ",") {
+			mm := matcherRe.FindStringSubmatch(item)
+			if mm == nil {
+				return nil, fmt.Errorf("%w: %s: label matcher %q", ErrInvalidRule, r.Name, item)
+			}
+			result.matchers = append(result.matchers, matcher{label: mm[1], value: mm[3], equal: mm[2] == "="})
+		}
+	}
+	var err error
+	if result.threshold, err = strconv.ParseFloat(m[5], 64); err != nil {
+		return nil, fmt.Errorf("%w: %s: threshold %q", ErrInvalidRule, r.Name, m[5])
+	}
+	if m[6] != "" {
+		if result.For, err = time.ParseDuration(m[6]); err != nil || result.For < 0 {
+			return nil, fmt.Errorf("%w: %s: duration %q", ErrInvalidRule, r.Name, m[6])
+		}
+	}
+	return result, nil
+}
+
+// Collector возвращает имя сборщика, который заполняет метрику правила.
+func (r *Rule) Collector() string {
+	return targets[r.metric].collector
+}
+
+// check сообщает, выполняется ли условие для значения v.
+func (r *Rule) check(v float64) bool {
+	switch r.op {
+	case ">":
+		return v > r.threshold
+	case ">=":
+		return v >= r.threshold
+	case "<":
+		return v < r.threshold
+	case "<=":
+		return v <= r.threshold
+	case "==":
+		return v == r.threshold
+	default:
+		return v != r.threshold
+	}
+}
+
+// points возвращает значения метрики правила во временных рядах снимка, метки которых подходят под условия.
+func (r *Rule) points(s *pb.Snapshot) []point {
+	all := targets[r.metric].points(s, r.field)
+	result := make([]point, 0, len(all))
+	for _, p := range all {
+		matched := true
+		for _, m := range r.matchers {
+			if !m.match(p.labels) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// key однозначно определяет временной ряд правила по набору меток.
+func key(rule string, labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString(rule)
+	for _, name := range names {
+		b.WriteString("," + name + "=" + labels[name])
+	}
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	t.Run("rule: field and duration", func(t *testing.T) {
+		r, err := ParseRule(config.AlertRule{Name: "cpu", Expr: "cpu.user > 90 for 60s"})
+		require.NoError(t, err)
+		require.Equal(t, "cpu_avg", r.Collector())
+		require.Equal(t, "user", r.field)
+		require.Equal(t, ">", r.op)
+		require.Equal(t, 90.0, r.threshold)
+		require.Equal(t, time.Minute, r.For)
+	})
+
+	t.Run("rule: labels and default field", func(t *testing.T) {
+		r, err := ParseRule(config.AlertRule{Name: "root", Expr: `disk_usage{mount=/, fs_type!="tmpfs"} >= 85`})
+		require.NoError(t, err)
+		require.Equal(t, "usage_percent", r.field)
+		require.Equal(t, []matcher{{label: "mount", value: "/", equal: true}, {label: "fs_type", value: "tmpfs"}}, r.matchers)
+		require.Zero(t, r.For)
+
+		points := r.points(&pb.Snapshot{DiskUsage: []*pb.DiskUsage{
+			{MountPoint: "/", FsType: "ext4", UsagePercent: 90},
+			{MountPoint: "/home", FsType: "ext4", UsagePercent: 95},
+		}})
+		require.Len(t, points, 1)
+		require.Equal(t, 90.0, points[0].value)
+		require.Equal(t, "/", points[0].labels["mount"])
+	})
+
+	t.Run("rule: errors", func(t *testing.T) {
+		for _, expr := range []string{
+			"cpu.user",
+			"cpu.user > high",
+			"cpu.user > 90 for ever",
+			"disk_usage{mount} > 90",
+		} {
+			_, err := ParseRule(config.AlertRule{Name: "bad", Expr: expr})
+			require.ErrorIs(t, err, ErrInvalidRule, expr)
+		}
+		for _, expr := range []string{"memory > 90", "cpu.steal > 10"} {
+			_, err := ParseRule(config.AlertRule{Name: "bad", Expr: expr})
+			require.ErrorIs(t, err, ErrUnknownMetric, expr)
+		}
+	})
+}
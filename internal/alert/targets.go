@@ -0,0 +1,205 @@
+package alert
+
+import (
+	"strconv"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// point - значение поля метрики во временном ряду снимка.
+type point struct {
+	labels map[string]string
+	value  float64
+}
+
+// selector извлекает из снимка значения полей метрики.
+type selector interface {
+	has(field string) bool
+	points(s *pb.Snapshot, field string) []point
+}
+
+// family - метрика, представленная в снимке списком элементов типа T. Каждый элемент - отдельный
+// временной ряд с метками labels. Метрика без меток представлена одним элементом.
+type family[T any] struct {
+	items  func(s *pb.Snapshot) []T
+	labels func(T) map[string]string
+	fields map[string]func(T) float64
+}
+
+func (f *family[T]) has(field string) bool {
+	_, ok := f.fields[field]
+	return ok
+}
+
+func (f *family[T]) points(s *pb.Snapshot, field string) []point {
+	get := f.fields[field]
+	items := f.items(s)
+	result := make([]point, 0, len(items))
+	for _, item := range items {
+		p := point{value: get(item)}
+		if f.labels != nil {
+			p.labels = f.labels(item)
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// single возвращает метрику снимка без меток. Пустое значение означает, что метрика отключена.
+func single[T comparable](get func(s *pb.Snapshot) T) func(s *pb.Snapshot) []T {
+	return func(s *pb.Snapshot) []T {
+		var zero T
+		if v := get(s); v != zero {
+			return []T{v}
+		}
+		return nil
+	}
+}
+
+func flag(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// target - метрика, доступная в правилах. collector - имя сборщика, который ее заполняет,
+// field - поле, которое используется, если в правиле оно не указано.
+type target struct {
+	selector
+	collector string
+	field     string
+}
+
+var targets = map[string]target{
+	"load_avg": {collector: config.CollectorLoadAvg, field: "one", selector: &family[*pb.LoadAverage]{
+		items: single(func(s *pb.Snapshot) *pb.LoadAverage { return s.LoadAvg }),
+		fields: map[string]func(*pb.LoadAverage) float64{
+			"one":           func(v *pb.LoadAverage) float64 { return v.One },
+			"five":          func(v *pb.LoadAverage) float64 { return v.Five },
+			"fifteen":       func(v *pb.LoadAverage) float64 { return v.Fifteen },
+			"running_tasks": func(v *pb.LoadAverage) float64 { return float64(v.RunningTasks) },
+			"total_threads": func(v *pb.LoadAverage) float64 { return float64(v.TotalThreads) },
+		},
+	}},
+	"cpu": {collector: config.CollectorCPUAvg, field: "user", selector: &family[*pb.CpuAverage]{
+		items: single(func(s *pb.Snapshot) *pb.CpuAverage { return s.CpuAvg }),
+		fields: map[string]func(*pb.CpuAverage) float64{
+			"user":             func(v *pb.CpuAverage) float64 { return v.User },
+			"system":           func(v *pb.CpuAverage) float64 { return v.System },
+			"idle":             func(v *pb.CpuAverage) float64 { return v.Idle },
+			"context_switches": func(v *pb.CpuAverage) float64 { return v.ContextSwitches },
+			"interrupts":       func(v *pb.CpuAverage) float64 { return v.Interrupts },
+			"forks":            func(v *pb.CpuAverage) float64 { return v.Forks },
+			"procs_running":    func(v *pb.CpuAverage) float64 { return v.ProcsRunning },
+			"procs_blocked":    func(v *pb.CpuAverage) float64 { return v.ProcsBlocked },
+		},
+	}},
+	"disk_usage": {collector: config.CollectorDiskUsage, field: "usage_percent", selector: &family[*pb.DiskUsage]{
+		items: func(s *pb.Snapshot) []*pb.DiskUsage { return s.DiskUsage },
+		labels: func(v *pb.DiskUsage) map[string]string {
+			return map[string]string{"mount": v.MountPoint, "device": v.Device, "fs_type": v.FsType}
+		},
+		fields: map[string]func(*pb.DiskUsage) float64{
+			"usage_percent":           func(v *pb.DiskUsage) float64 { return v.UsagePercent },
+			"inode_available_percent": func(v *pb.DiskUsage) float64 { return v.InodeAvailablePercent },
+			"total":                   func(v *pb.DiskUsage) float64 { return float64(v.Total) },
+			"used":                    func(v *pb.DiskUsage) float64 { return float64(v.Used) },
+			"free":                    func(v *pb.DiskUsage) float64 { return float64(v.Free) },
+			"inodes_free":             func(v *pb.DiskUsage) float64 { return float64(v.InodesFree) },
+			"time_to_full":            func(v *pb.DiskUsage) float64 { return v.TimeToFullSec },
+			"stale":                   func(v *pb.DiskUsage) float64 { return flag(v.Stale) },
+		},
+	}},
+	"disk_io": {collector: config.CollectorDiskIO, field: "tps", selector: &family[*pb.DiskIO]{
+		items: func(s *pb.Snapshot) []*pb.DiskIO { return s.DiskIO },
+		labels: func(v *pb.DiskIO) map[string]string {
+			return map[string]string{"name": v.Name, "label": v.Label, "kind": v.Kind}
+		},
+		fields: map[string]func(*pb.DiskIO) float64{
+			"tps":      func(v *pb.DiskIO) float64 { return v.Tps },
+			"rd_speed": func(v *pb.DiskIO) float64 { return v.RdSpeed },
+			"wr_speed": func(v *pb.DiskIO) float64 { return v.WrSpeed },
+		},
+	}},
+	"sensors": {collector: config.CollectorSensors, field: "value", selector: &family[*pb.Sensor]{
+		items: func(s *pb.Snapshot) []*pb.Sensor { return s.Sensors },
+		labels: func(v *pb.Sensor) map[string]string {
+			return map[string]string{"chip": v.Chip, "label": v.Label, "kind": v.Kind}
+		},
+		fields: map[string]func(*pb.Sensor) float64{
+			"value":    func(v *pb.Sensor) float64 { return v.Value },
+			"max":      func(v *pb.Sensor) float64 { return v.Max },
+			"critical": func(v *pb.Sensor) float64 { return v.Critical },
+		},
+	}},
+	"net_stack": {collector: config.CollectorNetStack, field: "tcp_retrans_segs", selector: &family[*pb.NetStack]{
+		items: single(func(s *pb.Snapshot) *pb.NetStack { return s.NetStack }),
+		fields: map[string]func(*pb.NetStack) float64{
+			"tcp_retrans_segs":  func(v *pb.NetStack) float64 { return v.TcpRetransSegs },
+			"tcp_out_rsts":      func(v *pb.NetStack) float64 { return v.TcpOutRsts },
+			"tcp_estab_resets":  func(v *pb.NetStack) float64 { return v.TcpEstabResets },
+			"tcp_attempt_fails": func(v *pb.NetStack) float64 { return v.TcpAttemptFails },
+			"tcp_in_errs":       func(v *pb.NetStack) float64 { return v.TcpInErrs },
+			"listen_overflows":  func(v *pb.NetStack) float64 { return v.ListenOverflows },
+			"listen_drops":      func(v *pb.NetStack) float64 { return v.ListenDrops },
+			"udp_rcvbuf_errors": func(v *pb.NetStack) float64 { return v.UdpRcvbufErrors },
+			"udp_sndbuf_errors": func(v *pb.NetStack) float64 { return v.UdpSndbufErrors },
+			"udp_in_errors":     func(v *pb.NetStack) float64 { return v.UdpInErrors },
+			"sockets_used":      func(v *pb.NetStack) float64 { return float64(v.SocketsUsed) },
+			"tcp_orphan":        func(v *pb.NetStack) float64 { return float64(v.TcpOrphan) },
+			"tcp_time_wait":     func(v *pb.NetStack) float64 { return float64(v.TcpTimeWait) },
+		},
+	}},
+	"conntrack": {collector: config.CollectorConntrack, field: "fill_percent", selector: &family[*pb.Conntrack]{
+		items: single(func(s *pb.Snapshot) *pb.Conntrack { return s.Conntrack }),
+		fields: map[string]func(*pb.Conntrack) float64{
+			"fill_percent":  func(v *pb.Conntrack) float64 { return v.FillPercent },
+			"count":         func(v *pb.Conntrack) float64 { return float64(v.Count) },
+			"insert_failed": func(v *pb.Conntrack) float64 { return v.InsertFailed },
+			"drop":          func(v *pb.Conntrack) float64 { return v.Drop },
+			"early_drop":    func(v *pb.Conntrack) float64 { return v.EarlyDrop },
+			"invalid":       func(v *pb.Conntrack) float64 { return v.Invalid },
+		},
+	}},
+	"raid": {collector: config.CollectorRAID, field: "degraded", selector: &family[*pb.RaidArray]{
+		items: func(s *pb.Snapshot) []*pb.RaidArray { return s.Raid },
+		labels: func(v *pb.RaidArray) map[string]string {
+			return map[string]string{"name": v.Name, "level": v.Level}
+		},
+		fields: map[string]func(*pb.RaidArray) float64{
+			"degraded":      func(v *pb.RaidArray) float64 { return flag(v.Degraded) },
+			"disks_active":  func(v *pb.RaidArray) float64 { return float64(v.DisksActive) },
+			"sync_progress": func(v *pb.RaidArray) float64 { return v.SyncProgress },
+		},
+	}},
+	"users": {collector: config.CollectorUsers, field: "cpu_percent", selector: &family[*pb.UserUsage]{
+		items: func(s *pb.Snapshot) []*pb.UserUsage { return s.Users },
+		labels: func(v *pb.UserUsage) map[string]string {
+			return map[string]string{"user": v.User, "uid": strconv.FormatUint(uint64(v.Uid), 10)}
+		},
+		fields: map[string]func(*pb.UserUsage) float64{
+			"cpu_percent": func(v *pb.UserUsage) float64 { return v.CpuPercent },
+			"rss_bytes":   func(v *pb.UserUsage) float64 { return float64(v.RssBytes) },
+			"processes":   func(v *pb.UserUsage) float64 { return float64(v.Processes) },
+			"connections": func(v *pb.UserUsage) float64 { return float64(v.Connections) },
+		},
+	}},
+	// Метки плагина дополняются метками plugin и name
+	"custom": {collector: config.CollectorPlugins, field: "value", selector: &family[*pb.CustomMetric]{
+		items: func(s *pb.Snapshot) []*pb.CustomMetric { return s.CustomMetrics },
+		labels: func(v *pb.CustomMetric) map[string]string {
+			result := make(map[string]string, len(v.Labels)+2)
+			for k, l := range v.Labels {
+				result[k] = l
+			}
+			result["plugin"] = v.Plugin
+			result["name"] = v.Name
+			return result
+		},
+		fields: map[string]func(*pb.CustomMetric) float64{
+			"value": func(v *pb.CustomMetric) float64 { return v.Value },
+		},
+	}},
+}
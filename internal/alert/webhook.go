@@ -0,0 +1,110 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+)
+
+// queueSize - число сообщений, ожидающих отправки. Если webhook долго недоступен, новые
+// сообщения отбрасываются, чтобы не задерживать проверку правил.
+const queueSize = 100
+
+// Message - тело запроса к webhook.
+type Message struct {
+	Host   string  `json:"host"`
+	Alerts []Alert `json:"alerts"`
+}
+
+// Notifier отправляет оповещения на адреса webhook в порядке их появления.
+type Notifier struct {
+	cfg    config.Alerts
+	host   string
+	log    logger.Logger
+	client *http.Client
+	queue  chan Message
+}
+
+func NewNotifier(cfg config.Alerts, host string, log logger.Logger) *Notifier {
+	return &Notifier{
+		cfg:    cfg,
+		host:   host,
+		log:    log,
+		client: &http.Client{Timeout: cfg.Timeout},
+		queue:  make(chan Message, queueSize),
+	}
+}
+
+// Run отправляет сообщения из очереди до отмены контекста.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-n.queue:
+			for _, url := range n.cfg.Webhooks {
+				if err := n.send(ctx, url, m); err != nil {
+					n.log.Error("Failed to send alerts", "webhook", url, "error", err.Error())
+				}
+			}
+		}
+	}
+}
+
+// Notify ставит оповещения в очередь на отправку.
+func (n *Notifier) Notify(alerts []Alert) {
+	if len(alerts) == 0 || len(n.cfg.Webhooks) == 0 {
+		return
+	}
+	select {
+	case n.queue <- Message{Host: n.host, Alerts: alerts}:
+	default:
+		n.log.Error("Alerts queue is full, alerts dropped", "count", len(alerts))
+	}
+}
+
+// send отправляет сообщение, повторяя попытку при ошибке соединения, ответах 5xx и 429.
+func (n *Notifier) send(ctx context.Context, url string, m Message) error {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	delay := n.cfg.RetryDelay
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		retry, err = n.post(ctx, url, body)
+		if err == nil || !retry || attempt >= n.cfg.Retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, url string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("unexpected status %s", resp.Status)
+}
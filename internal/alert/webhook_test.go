@@ -0,0 +1,74 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifier(t *testing.T) {
+	var calls atomic.Int32
+	received := make(chan Message, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Первые две попытки завершаются ошибкой сервера
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var m Message
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&m))
+		received <- m
+	}))
+	defer server.Close()
+
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	cfg := config.Alerts{
+		Webhooks:   []string{server.URL},
+		Timeout:    time.Second,
+		Retries:    2,
+		RetryDelay: time.Millisecond,
+	}
+	n := NewNotifier(cfg, "host-1", log)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	n.Notify([]Alert{{Rule: "cpu", State: StateFiring, Value: 95}})
+	select {
+	case m := <-received:
+		require.Equal(t, "host-1", m.Host)
+		require.Len(t, m.Alerts, 1)
+		require.Equal(t, "cpu", m.Alerts[0].Rule)
+		require.Equal(t, StateFiring, m.Alerts[0].State)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called")
+	}
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestNotifierClientError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	n := NewNotifier(config.Alerts{Timeout: time.Second, Retries: 3, RetryDelay: time.Millisecond}, "", log)
+	// Ошибка клиента не повторяется
+	err := n.send(context.Background(), server.URL, Message{})
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
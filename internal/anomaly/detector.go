@@ -0,0 +1,149 @@
+package anomaly
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+var ErrUnknownMetric = errors.New("unknown metric")
+
+// Значения по умолчанию для параметров детектора, не заданных в настройках.
+const (
+	defaultMethod    = "ewma"
+	defaultAlpha     = 0.05
+	defaultSeason    = 24 * time.Hour
+	defaultBuckets   = 24
+	defaultThreshold = 3
+	defaultWarmup    = 30
+)
+
+// Detector обучает базовые линии метрик по снимкам и оценивает по ним значения других снимков.
+// Методы безопасны для вызова из нескольких горутин.
+type Detector struct {
+	names   []string
+	metrics map[string]config.AnomalyDetector
+	mu      sync.Mutex
+	models  map[string]model
+}
+
+// New создает детектор для метрик из настроек.
+func New(cfg config.Anomaly) (*Detector, error) {
+	result := &Detector{
+		metrics: make(map[string]config.AnomalyDetector, len(cfg.Metrics)),
+		models:  make(map[string]model),
+	}
+	for name, m := range cfg.Metrics {
+		if _, ok := targets[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMetric, name)
+		}
+		result.names = append(result.names, name)
+		result.metrics[name] = withDefaults(name, m)
+	}
+	sort.Strings(result.names)
+	return result, nil
+}
+
+func withDefaults(name string, m config.AnomalyDetector) config.AnomalyDetector {
+	if m.Method == "" {
+		m.Method = defaultMethod
+	}
+	if m.Alpha == 0 {
+		m.Alpha = defaultAlpha
+	}
+	if m.Season == 0 {
+		m.Season = defaultSeason
+	}
+	if m.Buckets == 0 {
+		m.Buckets = defaultBuckets
+	}
+	if m.Threshold == 0 {
+		m.Threshold = defaultThreshold
+	}
+	if m.Warmup == 0 {
+		m.Warmup = defaultWarmup
+	}
+	if m.MinSpread == 0 {
+		m.MinSpread = targets[name].minSpread
+	}
+	return m
+}
+
+// Collectors возвращает имена сборщиков, метрики которых оцениваются детектором.
+func (d *Detector) Collectors() []string {
+	result := make([]string, 0, len(d.names))
+	for _, name := range d.names {
+		result = append(result, targets[name].collector)
+	}
+	return result
+}
+
+// points возвращает значения метрики name в снимке. Метрика, сборщик которой не прислал
+// измерений за окно, не оценивается и не обучает модели.
+func points(name string, s *pb.Snapshot) []point {
+	t := targets[name]
+	if s.Samples[t.collector] == 0 {
+		return nil
+	}
+	return t.points(s)
+}
+
+// Learn добавляет значения снимка в модели временных рядов.
+func (d *Detector) Learn(s *pb.Snapshot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	at := time.UnixMilli(s.WindowEnd)
+	for _, name := range d.names {
+		for _, p := range points(name, s) {
+			m, ok := d.models[p.key()]
+			if !ok {
+				m = d.newModel(name)
+				d.models[p.key()] = m
+			}
+			m.learn(p.value, at)
+		}
+	}
+}
+
+func (d *Detector) newModel(name string) model {
+	cfg := d.metrics[name]
+	if cfg.Method == "seasonal" {
+		return newSeasonal(cfg.Season, cfg.Buckets, cfg.Warmup)
+	}
+	return &ewma{alpha: cfg.Alpha, warmup: cfg.Warmup}
+}
+
+// Mark добавляет в элементы снимка оценки значений, для которых модель уже обучена.
+func (d *Detector) Mark(s *pb.Snapshot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	at := time.UnixMilli(s.WindowEnd)
+	for _, name := range d.names {
+		cfg := d.metrics[name]
+		for _, p := range points(name, s) {
+			m, ok := d.models[p.key()]
+			if !ok {
+				continue
+			}
+			baseline, spread, ok := m.estimate(at)
+			if !ok {
+				continue
+			}
+			v := score(p.value, baseline, spread, cfg.MinSpread)
+			*p.scores = append(*p.scores, &pb.AnomalyScore{
+				Field:     p.field,
+				Baseline:  baseline,
+				Score:     v,
+				Anomalous: math.Abs(v) >= cfg.Threshold,
+			})
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+)
+
+func snapshot(at time.Time, load float64, tps float64) *pb.Snapshot {
+	return &pb.Snapshot{
+		WindowStart: at.Add(-10 * time.Second).UnixMilli(),
+		WindowEnd:   at.UnixMilli(),
+		Samples:     map[string]uint32{"load_avg": 10, "disk_io": 10},
+		LoadAvg:     &pb.LoadAverage{One: load},
+		DiskIO:      []*pb.DiskIO{{Name: "sda", Tps: tps}},
+	}
+}
+
+func TestDetector(t *testing.T) {
+	d, err := New(config.Anomaly{Metrics: map[string]config.AnomalyDetector{
+		"load_avg": {Warmup: 5},
+		"disk_io":  {Method: "seasonal", Warmup: 5, Threshold: 5},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"disk_io", "load_avg"}, d.Collectors())
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		s := snapshot(start.Add(time.Duration(i)*time.Second), 1+float64(i%2)*0.2, 100+float64(i)*5)
+		// До окончания обучения оценки не передаются
+		d.Mark(s)
+		require.Empty(t, s.LoadAvg.Anomalies)
+		d.Learn(s)
+	}
+
+	s := snapshot(start.Add(5*time.Second), 1.1, 105)
+	d.Mark(s)
+	require.Len(t, s.LoadAvg.Anomalies, 1)
+	require.Equal(t, "one", s.LoadAvg.Anomalies[0].Field)
+	require.False(t, s.LoadAvg.Anomalies[0].Anomalous)
+	require.Len(t, s.DiskIO[0].Anomalies, 3)
+	require.False(t, s.DiskIO[0].Anomalies[0].Anomalous)
+
+	s = snapshot(start.Add(6*time.Second), 8, 2000)
+	d.Mark(s)
+	require.True(t, s.LoadAvg.Anomalies[0].Anomalous)
+	require.Greater(t, s.LoadAvg.Anomalies[0].Score, 3.0)
+	require.Equal(t, "tps", s.DiskIO[0].Anomalies[0].Field)
+	require.True(t, s.DiskIO[0].Anomalies[0].Anomalous)
+
+	// Метрика без измерений в окне не оценивается
+	s = snapshot(start.Add(7*time.Second), 8, 2000)
+	s.Samples["load_avg"] = 0
+	d.Mark(s)
+	require.Empty(t, s.LoadAvg.Anomalies)
+}
+
+func TestDetectorUnknownMetric(t *testing.T) {
+	_, err := New(config.Anomaly{Metrics: map[string]config.AnomalyDetector{"memory": {}}})
+	require.ErrorIs(t, err, ErrUnknownMetric)
+}
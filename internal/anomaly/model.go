@@ -0,0 +1,115 @@
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// bucketSamples - число значений, которые хранятся в одном интервале сезона. При периоде обучения
+	// 10 секунд и часовых интервалах это измерения за два последних дня.
+	bucketSamples = 720
+	// madScale приводит MAD к стандартному отклонению для нормального распределения.
+	madScale = 1.4826
+	// relSpread - наименьший разброс относительно базовой линии. Для ряда около нуля его дополняет
+	// абсолютный порог minSpread детектора: без него любое значение, отличающееся от постоянного ряда,
+	// например, от нулевой скорости простаивающего диска, получало бы предельную оценку.
+	relSpread = 0.01
+	// maxScore ограничивает оценку значения, отклонившегося от ряда с почти нулевым разбросом.
+	maxScore = 100
+)
+
+// model - базовая линия одного временного ряда.
+type model interface {
+	learn(v float64, at time.Time)
+	// estimate возвращает ожидаемое значение и разброс в момент at. ok - модель получила достаточно измерений
+	estimate(at time.Time) (baseline, spread float64, ok bool)
+}
+
+// score возвращает отклонение значения от базовой линии в единицах разброса. Разброс не бывает
+// меньше minSpread и доли relSpread от базовой линии.
+func score(v, baseline, spread, minSpread float64) float64 {
+	if v == baseline {
+		return 0
+	}
+	spread = max(spread, minSpread, relSpread*math.Abs(baseline))
+	if spread == 0 {
+		return math.Copysign(maxScore, v-baseline)
+	}
+	return max(-maxScore, min(maxScore, (v-baseline)/spread))
+}
+
+// ewma - экспоненциально взвешенные среднее и дисперсия.
+type ewma struct {
+	alpha    float64
+	warmup   int
+	n        int
+	mean     float64
+	variance float64
+}
+
+func (m *ewma) learn(v float64, _ time.Time) {
+	if m.n == 0 {
+		m.mean = v
+	} else {
+		diff := v - m.mean
+		incr := m.alpha * diff
+		m.mean += incr
+		m.variance = (1 - m.alpha) * (m.variance + diff*incr)
+	}
+	m.n++
+}
+
+func (m *ewma) estimate(_ time.Time) (float64, float64, bool) {
+	return m.mean, math.Sqrt(m.variance), m.n >= m.warmup
+}
+
+// seasonal - медиана и MAD значений, попавших в тот же интервал сезона. Сезон отсчитывается
+// от нулевого времени, поэтому суточный сезон начинается в полночь UTC.
+type seasonal struct {
+	season  time.Duration
+	warmup  int
+	buckets [][]float64
+}
+
+func newSeasonal(season time.Duration, buckets, warmup int) *seasonal {
+	return &seasonal{season: season, warmup: warmup, buckets: make([][]float64, buckets)}
+}
+
+func (m *seasonal) bucket(at time.Time) int {
+	offset := at.Sub(at.Truncate(m.season))
+	return int(offset * time.Duration(len(m.buckets)) / m.season)
+}
+
+func (m *seasonal) learn(v float64, at time.Time) {
+	i := m.bucket(at)
+	values := append(m.buckets[i], v)
+	if len(values) > bucketSamples {
+		values = values[len(values)-bucketSamples:]
+	}
+	m.buckets[i] = values
+}
+
+func (m *seasonal) estimate(at time.Time) (float64, float64, bool) {
+	values := m.buckets[m.bucket(at)]
+	if len(values) == 0 || len(values) < m.warmup {
+		return 0, 0, false
+	}
+	sorted := append([]float64(nil), values...)
+	med := median(sorted)
+	for i, v := range sorted {
+		sorted[i] = math.Abs(v - med)
+	}
+	return med, madScale * median(sorted), true
+}
+
+// median сортирует значения и возвращает их медиану.
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
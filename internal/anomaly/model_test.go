@@ -0,0 +1,73 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModels(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ewma", func(t *testing.T) {
+		m := &ewma{alpha: 0.1, warmup: 10}
+		for i := 0; i < 9; i++ {
+			m.learn(float64(10+i%2), start)
+		}
+		_, _, ok := m.estimate(start)
+		require.False(t, ok)
+		m.learn(10, start)
+		baseline, spread, ok := m.estimate(start)
+		require.True(t, ok)
+		require.InDelta(t, 10.5, baseline, 0.5)
+		require.Greater(t, spread, 0.0)
+		require.Less(t, spread, 1.0)
+	})
+
+	t.Run("seasonal", func(t *testing.T) {
+		m := newSeasonal(24*time.Hour, 24, 3)
+		// Ночью значения около 10, днем - около 80
+		for day := 0; day < 3; day++ {
+			for _, v := range []float64{9, 10, 11} {
+				m.learn(v, start.Add(time.Duration(day)*24*time.Hour+2*time.Hour))
+				m.learn(v+70, start.Add(time.Duration(day)*24*time.Hour+14*time.Hour))
+			}
+		}
+		baseline, spread, ok := m.estimate(start.Add(2*time.Hour + 30*time.Minute))
+		require.True(t, ok)
+		require.Equal(t, 10.0, baseline)
+		require.InDelta(t, madScale, spread, 0.0001)
+		baseline, _, ok = m.estimate(start.Add(14 * time.Hour))
+		require.True(t, ok)
+		require.Equal(t, 80.0, baseline)
+		_, _, ok = m.estimate(start.Add(20 * time.Hour))
+		require.False(t, ok)
+	})
+
+	t.Run("score", func(t *testing.T) {
+		require.Equal(t, 2.0, score(14, 10, 2, 0))
+		require.Equal(t, -2.0, score(6, 10, 2, 0))
+		require.Zero(t, score(0, 0, 0, 0))
+		require.Equal(t, float64(maxScore), score(1, 0, 0, 0))
+		// Разброс не меньше доли базовой линии и абсолютного порога
+		require.Equal(t, 10.0, score(110, 100, 0, 0))
+		require.Equal(t, 0.5, score(1, 0, 0, 2))
+	})
+
+	t.Run("zero series", func(t *testing.T) {
+		m := &ewma{alpha: defaultAlpha, warmup: defaultWarmup}
+		for i := 0; i < defaultWarmup; i++ {
+			m.learn(0, start)
+		}
+		baseline, spread, ok := m.estimate(start)
+		require.True(t, ok)
+		require.Zero(t, baseline)
+		require.Zero(t, spread)
+		// Небольшая нагрузка после простоя не считается аномалией
+		cfg := withDefaults("disk_io", config.AnomalyDetector{})
+		require.Less(t, score(4, baseline, spread, cfg.MinSpread), cfg.Threshold)
+		require.GreaterOrEqual(t, score(1000, baseline, spread, cfg.MinSpread), cfg.Threshold)
+	})
+}
@@ -0,0 +1,71 @@
+package anomaly
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// point - значение поля метрики в снимке. Оценка значения добавляется в scores элемента снимка.
+type point struct {
+	series string
+	field  string
+	value  float64
+	scores *[]*pb.AnomalyScore
+}
+
+func (p point) key() string {
+	return p.series + "." + p.field
+}
+
+// target - метрика, для которой доступно обнаружение аномалий. collector - имя сборщика, который ее заполняет,
+// minSpread - наименьший разброс по умолчанию в единицах метрики.
+type target struct {
+	collector string
+	minSpread float64
+	points    func(s *pb.Snapshot) []point
+}
+
+var targets = map[string]target{
+	"load_avg": {collector: config.CollectorLoadAvg, minSpread: 0.05, points: func(s *pb.Snapshot) []point {
+		if s.LoadAvg == nil {
+			return nil
+		}
+		return []point{{series: "load_avg", field: "one", value: s.LoadAvg.One, scores: &s.LoadAvg.Anomalies}}
+	}},
+	"cpu_avg": {collector: config.CollectorCPUAvg, minSpread: 1, points: func(s *pb.Snapshot) []point {
+		if s.CpuAvg == nil {
+			return nil
+		}
+		return []point{
+			{series: "cpu_avg", field: "user", value: s.CpuAvg.User, scores: &s.CpuAvg.Anomalies},
+			{series: "cpu_avg", field: "system", value: s.CpuAvg.System, scores: &s.CpuAvg.Anomalies},
+		}
+	}},
+	"disk_io": {collector: config.CollectorDiskIO, minSpread: 10, points: func(s *pb.Snapshot) []point {
+		result := make([]point, 0, 3*len(s.DiskIO))
+		for _, v := range s.DiskIO {
+			series := "disk_io{" + v.Name + "}"
+			result = append(result,
+				point{series: series, field: "tps", value: v.Tps, scores: &v.Anomalies},
+				point{series: series, field: "rd_speed", value: v.RdSpeed, scores: &v.Anomalies},
+				point{series: series, field: "wr_speed", value: v.WrSpeed, scores: &v.Anomalies},
+			)
+		}
+		return result
+	}},
+	// Трафик протокола оценивается по средней скорости за окно снимка
+	"traffic": {collector: config.CollectorNetPackets, minSpread: 1024, points: func(s *pb.Snapshot) []point {
+		seconds := float64(s.WindowEnd-s.WindowStart) / 1000
+		if seconds <= 0 {
+			return nil
+		}
+		result := make([]point, 0, len(s.NetTopByProtocol))
+		for _, v := range s.NetTopByProtocol {
+			result = append(result, point{
+				series: "traffic{" + v.Protocol + "}", field: "bytes_per_sec", value: float64(v.Bytes) / seconds,
+				scores: &v.Anomalies,
+			})
+		}
+		return result
+	}},
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/skushnerchuk/simda/internal/delta"
 	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/recording"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -19,15 +22,33 @@ type SimdaClient struct {
 	logger        logger.Logger
 	warm          uint
 	receivePeriod uint
+	resync        uint
 	host, port    string
 	err           error
 	ch            chan *pb.Snapshot
+	decoder       *delta.Decoder
+	recorder      *recording.Writer
+	queries       []*pb.Query
 }
 
-func NewClient(warm, receive uint, host, port string) *SimdaClient {
+// NewClient создает клиента. Если resync больше 0, снимки запрашиваются в виде изменений,
+// а полный снимок передается каждое resync-е сообщение. Если recorder задан, в него записываются
+// полные снимки после восстановления изменений. Запросы queries передаются серверу для отбора
+// и сортировки списков снимка.
+func NewClient(
+	warm, receive, resync uint, host, port string, recorder *recording.Writer, queries []*pb.Query,
+) *SimdaClient {
+	var decoder *delta.Decoder
+	if resync > 0 {
+		decoder = delta.NewDecoder()
+	}
 	return &SimdaClient{
 		warm:          warm,
 		receivePeriod: receive,
+		resync:        resync,
+		decoder:       decoder,
+		recorder:      recorder,
+		queries:       queries,
 		host:          host,
 		port:          port,
 		id:            uuid.New(),
@@ -65,6 +86,9 @@ func (d *SimdaClient) ListenStream(ctx context.Context) (pb.Simda_StreamSnapshot
 	in := &pb.Request{
 		Period:  uint32(d.receivePeriod),
 		Warming: uint32(d.warm),
+		Delta:   d.decoder != nil,
+		Resync:  uint32(d.resync),
+		Queries: d.queries,
 	}
 	return client.StreamSnapshots(ctx, in)
 }
@@ -82,6 +106,14 @@ func (d *SimdaClient) receive(ctx context.Context, stream pb.Simda_StreamSnapsho
 					stop()
 					return
 				}
+				if err == nil && d.decoder != nil {
+					err = d.decoder.Decode(snapshot)
+				}
+				if err == nil && d.recorder != nil {
+					if err = d.recorder.Write(snapshot, time.Now()); err != nil {
+						err = fmt.Errorf("failed to record snapshot: %w", err)
+					}
+				}
 				if err != nil {
 					d.err = err
 					stop()
@@ -7,9 +7,10 @@ import (
 )
 
 var (
-	caption = "Server: %s (warm: %d sec, recv: %d sec), status: %s"
+	caption = "Server: %s%s (warm: %d sec, recv: %d sec), status: %s%s"
 	paused  = "[red::b]paused[::-]"
 	running = "[green::b]running[::-]"
+	lost    = ", lost: [red::b]%d[::-]"
 )
 
 type ViewConnections struct {
@@ -17,6 +18,10 @@ type ViewConnections struct {
 	server  string
 	warm    int
 	receive int
+	status  string
+	host    string
+	seq     uint64
+	lost    uint64
 }
 
 func NewConnectionView(server string, warm, receive int) *ViewConnections {
@@ -34,10 +39,34 @@ func NewConnectionView(server string, warm, receive int) *ViewConnections {
 	return &v
 }
 
+// SetData запоминает узел, приславший снимок, и считает снимки, пропущенные в потоке.
+func (v *ViewConnections) SetData(host string, seq uint64) {
+	if v.seq > 0 && seq > v.seq+1 {
+		v.lost += seq - v.seq - 1
+	}
+	v.seq = seq
+	v.host = host
+	v.render()
+}
+
 func (v *ViewConnections) Pause() {
-	v.View.SetText(fmt.Sprintf(caption, v.server, v.warm, v.receive, paused))
+	v.status = paused
+	v.render()
 }
 
 func (v *ViewConnections) Resume() {
-	v.View.SetText(fmt.Sprintf(caption, v.server, v.warm, v.receive, running))
+	v.status = running
+	v.render()
+}
+
+func (v *ViewConnections) render() {
+	host := ""
+	if v.host != "" {
+		host = " " + tview.Escape("["+v.host+"]")
+	}
+	gaps := ""
+	if v.lost > 0 {
+		gaps = fmt.Sprintf(lost, v.lost)
+	}
+	v.View.SetText(fmt.Sprintf(caption, v.server, host, v.warm, v.receive, v.status, gaps))
 }
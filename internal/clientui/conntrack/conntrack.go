@@ -0,0 +1,82 @@
+package conntrack
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+// fillAlertPercent - заполнение таблицы, начиная с которого новые соединения рискуют быть отброшены.
+const fillAlertPercent = 80
+
+type counter struct {
+	name  string
+	value string
+	alert bool
+}
+
+type ViewConntrack struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewConntrackView() *ViewConntrack {
+	cols := []uiutils.Column{
+		{Text: "Counter", MaxWidth: 0},
+		{Text: "Value", MaxWidth: 0},
+		{Text: "Protocol", MaxWidth: 0},
+		{Text: "State", MaxWidth: 0},
+		{Text: "Entries", MaxWidth: 0},
+	}
+	v := ViewConntrack{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatRate(value float64) string {
+	return fmt.Sprint(utils.RoundFloat(value, 2))
+}
+
+// SetData выводит заполнение таблицы и счетчики ошибок, справа - разбивку записей
+// по протоколам и состояниям, если она включена в настройках демона.
+func (v *ViewConntrack) SetData(data *pb.Conntrack, enabled bool) {
+	v.View.Clear()
+
+	if !enabled || data == nil {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	counters := []counter{
+		{"Entries", fmt.Sprint(data.Count), false},
+		{"Max entries", fmt.Sprint(data.Max), false},
+		{"Fill %", fmt.Sprint(utils.RoundFloat(data.FillPercent, 1)), data.FillPercent >= fillAlertPercent},
+		{"Insert failed/s", formatRate(data.InsertFailed), data.InsertFailed > 0},
+		{"Drops/s", formatRate(data.Drop), data.Drop > 0},
+		{"Early drops/s", formatRate(data.EarlyDrop), data.EarlyDrop > 0},
+		{"Invalid/s", formatRate(data.Invalid), false},
+	}
+	for i, c := range counters {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(c.name, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(c.value, 0, tview.AlignLeft))
+		if c.alert {
+			v.View.GetCell(i+1, 0).SetTextColor(theme.AlertColor)
+			v.View.GetCell(i+1, 1).SetTextColor(theme.AlertColor)
+		}
+	}
+	for i, e := range data.Entries {
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(e.Protocol, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(e.State, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 4, uiutils.CreateCell(fmt.Sprint(e.Count), 0, tview.AlignLeft))
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	"github.com/skushnerchuk/simda/internal/clientui/utils"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 )
 
@@ -39,8 +40,16 @@ func (v *ViewCPUAvg) SetData(data *pb.CpuAverage, enabled bool) {
 
 	s := defaultUnknownTitle
 	if data != nil {
-		s = defaultTitle + "[orange::-]sys[white] %.2f [orange]usr[white] %.2f [orange]idl[white] %.2f"
-		s = fmt.Sprintf(s, data.System, data.User, data.Idle)
+		s = defaultTitle + "[orange::-]sys[white] %s [orange]usr[white] %s [orange]idl[white] %.2f"
+		s = fmt.Sprintf(s, value(data, "system", data.System), value(data, "user", data.User), data.Idle)
 	}
 	v.View.SetText(s)
 }
+
+// value форматирует долю процессора, аномальное значение выделяется цветом.
+func value(data *pb.CpuAverage, field string, v float64) string {
+	if utils.Anomalous(data.Anomalies, field) {
+		return fmt.Sprintf("[%s]%.2f[white]", theme.AlertColor.String(), v)
+	}
+	return fmt.Sprintf("%.2f", v)
+}
@@ -0,0 +1,66 @@
+package custom
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const colLabelsWidth = 40
+
+type ViewCustomMetrics struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewCustomMetricsView() *ViewCustomMetrics {
+	cols := []uiutils.Column{
+		{Text: "Plugin", MaxWidth: 0},
+		{Text: "Metric", MaxWidth: 0},
+		{Text: "Labels", MaxWidth: colLabelsWidth},
+		{Text: "Value", MaxWidth: 0},
+	}
+	v := ViewCustomMetrics{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatLabels(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, labels[name]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (v *ViewCustomMetrics) SetData(data []*pb.CustomMetric, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Plugin, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(d.Name, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(formatLabels(d.Labels), colLabelsWidth, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(fmt.Sprint(utils.RoundFloat(d.Value, 2)), 0, tview.AlignLeft))
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -3,6 +3,7 @@ package diskio
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/rivo/tview"
 	"github.com/skushnerchuk/simda/internal/clientui/theme"
@@ -18,11 +19,15 @@ var (
 	defaultFocusedDisabledTitle = fmt.Sprintf("[%s::b] Disk I/O 🔴 ", theme.FocusedBorderColor.String())
 )
 
+// anomalyColumns - колонки таблицы и поля, по которым детектор аномалий оценивает устройство.
+var anomalyColumns = map[int]string{1: "tps", 2: "rd_speed", 3: "wr_speed"}
+
 type ViewDiskIO struct {
 	View    *tview.Table
 	cols    []uiutils.Column
 	focused bool
 	enabled bool
+	ordered bool
 }
 
 func NewDiskIOView() *ViewDiskIO {
@@ -73,6 +78,23 @@ func (v *ViewDiskIO) SetMaxWidth(w int) {
 	}
 }
 
+// deviceName возвращает имя device-mapper вместо dm-N, для разделов и виртуальных
+// устройств через двоеточие добавляются физические диски.
+func deviceName(d *pb.DiskIO) string {
+	name := d.Name
+	if d.Label != "" {
+		name = d.Label
+	}
+	if len(d.Disks) > 0 {
+		name += ":" + strings.Join(d.Disks, ",")
+	}
+	return name
+}
+
+func (v *ViewDiskIO) SetOrdered(ordered bool) {
+	v.ordered = ordered
+}
+
 func (v *ViewDiskIO) SetData(data []*pb.DiskIO, enabled bool) {
 	v.enabled = enabled
 	v.View.Clear()
@@ -82,7 +104,9 @@ func (v *ViewDiskIO) SetData(data []*pb.DiskIO, enabled bool) {
 		return
 	}
 
-	sort.Slice(data, func(i, j int) bool { return data[i].WrSpeed > data[j].WrSpeed })
+	if !v.ordered {
+		sort.Slice(data, func(i, j int) bool { return data[i].WrSpeed > data[j].WrSpeed })
+	}
 
 	for idx, column := range v.cols {
 		v.View.SetCell(0, idx,
@@ -91,7 +115,7 @@ func (v *ViewDiskIO) SetData(data []*pb.DiskIO, enabled bool) {
 	}
 
 	for i, d := range data {
-		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Name, 8, tview.AlignCenter))
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(deviceName(d), 8, tview.AlignCenter))
 
 		s := fmt.Sprintf("%.2f", utils.RoundFloat(d.Tps, 2))
 		v.View.SetCell(i+1, 1, uiutils.CreateCell(s, 8, tview.AlignCenter))
@@ -104,6 +128,12 @@ func (v *ViewDiskIO) SetData(data []*pb.DiskIO, enabled bool) {
 
 		s = fmt.Sprintf("%.2f", utils.RoundFloat(d.WrSpeed+d.RdSpeed, 2))
 		v.View.SetCell(i+1, 4, uiutils.CreateCell(s, 8, tview.AlignCenter))
+
+		for col, field := range anomalyColumns {
+			if uiutils.Anomalous(d.Anomalies, field) {
+				v.View.GetCell(i+1, col).SetTextColor(theme.AlertColor)
+			}
+		}
 	}
 	v.View.SetFixed(1, 0)
 }
@@ -3,6 +3,7 @@ package diskusage
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/rivo/tview"
@@ -25,16 +26,21 @@ type ViewDiskUsage struct {
 	cols     []uiutils.Column
 	focused  bool
 	enabled  bool
+	ordered  bool
 }
 
 func NewDiskUsageView() *ViewDiskUsage {
 	cols := []uiutils.Column{
 		{Text: "Device", MaxWidth: 0},
 		{Text: "Mounted", MaxWidth: 10},
+		{Text: "Type", MaxWidth: 0},
+		{Text: "Size", MaxWidth: 0},
 		{Text: "Usage", MaxWidth: 0},
+		{Text: "Free", MaxWidth: 0},
 		{Text: "Usage %", MaxWidth: 0},
 		{Text: "Inode", MaxWidth: 0},
 		{Text: "Inode %", MaxWidth: 0},
+		{Text: "Full in", MaxWidth: 0},
 	}
 	v := ViewDiskUsage{View: uiutils.CreateTable(cols, " Disk usage "), cols: cols}
 	v.View.SetTitle(defaultTitle)
@@ -66,10 +72,32 @@ func (v *ViewDiskUsage) SetTitle() {
 	}
 }
 
+func timeToFull(seconds float64) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	d := time.Duration(seconds) * time.Second
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
 func (v *ViewDiskUsage) SetMaxWidth(w int) {
 	v.maxWidth = w
 }
 
+func (v *ViewDiskUsage) SetOrdered(ordered bool) {
+	v.ordered = ordered
+}
+
 func (v *ViewDiskUsage) SetData(data []*pb.DiskUsage, enabled bool) {
 	v.enabled = enabled
 	v.View.Clear()
@@ -78,7 +106,9 @@ func (v *ViewDiskUsage) SetData(data []*pb.DiskUsage, enabled bool) {
 	if !enabled {
 		return
 	}
-	sort.Slice(data, func(i, j int) bool { return data[i].Device < data[j].Device })
+	if !v.ordered {
+		sort.Slice(data, func(i, j int) bool { return data[i].Device < data[j].Device })
+	}
 
 	for idx, column := range v.cols {
 		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
@@ -88,16 +118,36 @@ func (v *ViewDiskUsage) SetData(data []*pb.DiskUsage, enabled bool) {
 		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Device, 0, tview.AlignLeft))
 		v.View.SetCell(i+1, 1, uiutils.CreateCell(d.MountPoint, 10, tview.AlignLeft))
 
-		v.View.SetCell(i+1, 2, uiutils.CreateCell(humanize.Bytes(uint64(d.Usage)), 0, tview.AlignLeft))
+		fsType := d.FsType
+		if d.ReadOnly {
+			fsType += ",ro"
+		}
+		if d.Stale {
+			fsType += ",stale"
+		}
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(fsType, 0, tview.AlignLeft))
+
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(humanize.Bytes(d.Total), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 4, uiutils.CreateCell(humanize.Bytes(uint64(d.Usage)), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 5, uiutils.CreateCell(humanize.Bytes(d.Free), 0, tview.AlignLeft))
 
 		s := fmt.Sprintf("%.2f", utils.RoundFloat(d.UsagePercent, 2))
-		v.View.SetCell(i+1, 3, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 6, uiutils.CreateCell(s, 0, tview.AlignLeft))
 
 		s = fmt.Sprintf("%d", uint64(d.InodeCount))
-		v.View.SetCell(i+1, 4, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 7, uiutils.CreateCell(s, 0, tview.AlignLeft))
 
 		s = fmt.Sprintf("%.2f", utils.RoundFloat(d.InodeAvailablePercent, 2))
-		v.View.SetCell(i+1, 5, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 8, uiutils.CreateCell(s, 0, tview.AlignLeft))
+
+		v.View.SetCell(i+1, 9, uiutils.CreateCell(timeToFull(d.TimeToFullSec), 0, tview.AlignLeft))
+
+		// Файловые системы, которые заполнятся раньше порога, выделяются цветом
+		if d.FillingSoon {
+			for col := range v.cols {
+				v.View.GetCell(i+1, col).SetTextColor(theme.AlertColor)
+			}
+		}
 	}
 	v.View.SetFixed(1, 0)
 }
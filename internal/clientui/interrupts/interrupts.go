@@ -0,0 +1,74 @@
+package interrupts
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const colDeviceWidth = 24
+
+type ViewInterrupts struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewInterruptsView() *ViewInterrupts {
+	cols := []uiutils.Column{
+		{Text: "IRQ", MaxWidth: 0},
+		{Text: "Device", MaxWidth: colDeviceWidth},
+		{Text: "Per sec", MaxWidth: 0},
+		{Text: "Top CPU", MaxWidth: 0},
+	}
+	v := ViewInterrupts{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatRate(value float64) string {
+	return fmt.Sprint(utils.RoundFloat(value, 1))
+}
+
+// SetData выводит прерывания с разбивкой по процессорам. Количество колонок процессоров
+// определяется по данным, прерывания с дисбалансом выделяются цветом.
+func (v *ViewInterrupts) SetData(data []*pb.Interrupt, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	cpus := 0
+	for _, d := range data {
+		cpus = max(cpus, len(d.PerCpu))
+	}
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+	for i := 0; i < cpus; i++ {
+		v.View.SetCell(0, len(v.cols)+i, uiutils.CreateHeaderCell(fmt.Sprintf("CPU%d", i), 0, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		top := fmt.Sprintf("CPU%d %.0f%%", d.TopCpu, d.TopCpuPercent)
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Irq, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(d.Device, colDeviceWidth, tview.AlignLeft))
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(formatRate(d.Total), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(top, 0, tview.AlignLeft))
+		for cpu, rate := range d.PerCpu {
+			v.View.SetCell(i+1, len(v.cols)+cpu, uiutils.CreateCell(formatRate(rate), 0, tview.AlignLeft))
+		}
+		if d.Imbalanced {
+			for col := 0; col < len(v.cols)+len(d.PerCpu); col++ {
+				v.View.GetCell(i+1, col).SetTextColor(theme.AlertColor)
+			}
+		}
+	}
+	v.View.SetFixed(1, 2)
+	v.View.ScrollToBeginning()
+}
@@ -0,0 +1,80 @@
+package kernel
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+type row struct {
+	name  string
+	value string
+}
+
+type ViewKernel struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewKernelView() *ViewKernel {
+	cols := []uiutils.Column{
+		{Text: "Activity", MaxWidth: 0},
+		{Text: "Value", MaxWidth: 0},
+		{Text: "Softirq", MaxWidth: 0},
+		{Text: "Per sec", MaxWidth: 0},
+	}
+	v := ViewKernel{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatRate(value float64) string {
+	return fmt.Sprint(utils.RoundFloat(value, 2))
+}
+
+// SetData отображает активность ядра: скорости из /proc/stat и /proc/softirqs
+// приходят в метрике cpu, количество задач и потоков - в load average.
+func (v *ViewKernel) SetData(load *pb.LoadAverage, cpu *pb.CpuAverage, loadEnabled, cpuEnabled bool) {
+	v.View.Clear()
+
+	rows := make([]row, 0)
+	if cpuEnabled && cpu != nil {
+		rows = append(rows,
+			row{"Context switches/s", formatRate(cpu.ContextSwitches)},
+			row{"Interrupts/s", formatRate(cpu.Interrupts)},
+			row{"Forks/s", formatRate(cpu.Forks)},
+			row{"Runnable tasks", formatRate(cpu.ProcsRunning)},
+			row{"Blocked tasks", formatRate(cpu.ProcsBlocked)},
+		)
+	}
+	if loadEnabled && load != nil {
+		rows = append(rows,
+			row{"Running tasks", fmt.Sprint(load.RunningTasks)},
+			row{"Threads", fmt.Sprint(load.TotalThreads)},
+			row{"Last pid", fmt.Sprint(load.LastPid)},
+		)
+	}
+	if len(rows) == 0 {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+	for i, r := range rows {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(r.name, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(r.value, 0, tview.AlignLeft))
+	}
+	if cpuEnabled && cpu != nil {
+		for i, s := range cpu.Softirqs {
+			v.View.SetCell(i+1, 2, uiutils.CreateCell(s.Name, 0, tview.AlignLeft))
+			v.View.SetCell(i+1, 3, uiutils.CreateCell(formatRate(s.Rate), 0, tview.AlignLeft))
+		}
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -0,0 +1,85 @@
+package kernelevents
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const (
+	// maxEvents - число последних событий, которые хранит панель
+	maxEvents       = 100
+	colMessageWidth = 60
+	// errLevel - уровень syslog err, события этого уровня и выше выделяются цветом
+	errLevel = 3
+)
+
+// ViewKernelEvents - последние события журнала ядра. Снимок содержит только новые события,
+// поэтому панель накапливает их между снимками.
+type ViewKernelEvents struct {
+	View   *tview.Table
+	cols   []uiutils.Column
+	events []*pb.KernelEvent
+}
+
+func NewKernelEventsView() *ViewKernelEvents {
+	cols := []uiutils.Column{
+		{Text: "Time", MaxWidth: 0},
+		{Text: "Event", MaxWidth: 0},
+		{Text: "Device", MaxWidth: 0},
+		{Text: "Process", MaxWidth: 0},
+		{Text: "Message", MaxWidth: colMessageWidth},
+	}
+	v := ViewKernelEvents{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatProcess(e *pb.KernelEvent) string {
+	if e.Process == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s[%d]", e.Process, e.Pid)
+}
+
+// Add сохраняет новые события. Вызывается и при приостановленном обновлении экрана,
+// чтобы события за время паузы не терялись.
+func (v *ViewKernelEvents) Add(data []*pb.KernelEvent) {
+	v.events = append(v.events, data...)
+	if len(v.events) > maxEvents {
+		v.events = v.events[len(v.events)-maxEvents:]
+	}
+}
+
+// SetData выводит сохраненные события, начиная с самого свежего.
+func (v *ViewKernelEvents) SetData(enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+	for i := range v.events {
+		e := v.events[len(v.events)-1-i]
+		values := []string{
+			time.UnixMilli(e.Time).Format(time.TimeOnly), e.Type, e.Device, formatProcess(e), e.Message,
+		}
+		for col, value := range values {
+			cell := uiutils.CreateCell(value, v.cols[col].MaxWidth, tview.AlignLeft)
+			if e.Level <= errLevel {
+				cell.SetTextColor(theme.AlertColor)
+			}
+			v.View.SetCell(i+1, col, cell)
+		}
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
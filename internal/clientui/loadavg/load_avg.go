@@ -40,8 +40,13 @@ func (v *ViewLoadAvg) SetData(data *pb.LoadAverage, enabled bool) {
 	}
 
 	if data != nil && !math.IsNaN(data.One) && !math.IsNaN(data.Five) && !math.IsNaN(data.Fifteen) {
-		s := defaultTitle + "%.2f %.2f %.2f"
-		s = fmt.Sprintf(s, data.One, data.Five, data.Fifteen)
+		// Аномальная загрузка за минуту выделяется цветом
+		one := fmt.Sprintf("%.2f", data.One)
+		if utils.Anomalous(data.Anomalies, "one") {
+			one = fmt.Sprintf("[%s]%s[white]", theme.AlertColor.String(), one)
+		}
+		s := defaultTitle + "%s %.2f %.2f [orange::-]tasks[white] %d/%d"
+		s = fmt.Sprintf(s, one, data.Five, data.Fifteen, data.RunningTasks, data.TotalThreads)
 		v.View.SetText(s)
 	}
 }
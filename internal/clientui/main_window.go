@@ -7,17 +7,31 @@ import (
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/skushnerchuk/simda/internal/clientui/connection"
+	"github.com/skushnerchuk/simda/internal/clientui/conntrack"
 	"github.com/skushnerchuk/simda/internal/clientui/cpuavg"
+	"github.com/skushnerchuk/simda/internal/clientui/custom"
 	"github.com/skushnerchuk/simda/internal/clientui/diskio"
 	"github.com/skushnerchuk/simda/internal/clientui/diskusage"
+	"github.com/skushnerchuk/simda/internal/clientui/interrupts"
+	"github.com/skushnerchuk/simda/internal/clientui/kernel"
+	"github.com/skushnerchuk/simda/internal/clientui/kernelevents"
 	"github.com/skushnerchuk/simda/internal/clientui/loadavg"
 	"github.com/skushnerchuk/simda/internal/clientui/netconnections"
+	"github.com/skushnerchuk/simda/internal/clientui/netdns"
+	"github.com/skushnerchuk/simda/internal/clientui/netstack"
 	"github.com/skushnerchuk/simda/internal/clientui/netstates"
 	"github.com/skushnerchuk/simda/internal/clientui/nettabs"
 	"github.com/skushnerchuk/simda/internal/clientui/nettopbyconnection"
+	"github.com/skushnerchuk/simda/internal/clientui/nettopbyprocess"
 	"github.com/skushnerchuk/simda/internal/clientui/nettopbyprotocol"
+	"github.com/skushnerchuk/simda/internal/clientui/numa"
+	"github.com/skushnerchuk/simda/internal/clientui/raid"
+	"github.com/skushnerchuk/simda/internal/clientui/sensors"
 	"github.com/skushnerchuk/simda/internal/clientui/statusbar"
+	"github.com/skushnerchuk/simda/internal/clientui/systabs"
 	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	"github.com/skushnerchuk/simda/internal/clientui/users"
+	"github.com/skushnerchuk/simda/internal/query"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 )
 
@@ -40,9 +54,22 @@ type ViewMainWindow struct {
 	netConnStatesView     *netstates.NetworkConnectionsStatesView
 	netConnByProtocolView *nettopbyprotocol.ViewNetConnectionsByProtocol
 	netConnByClientView   *nettopbyconnection.ViewNetConnectionsByClient
+	netConnByProcessView  *nettopbyprocess.ViewNetConnectionsByProcess
+	netDNSView            *netdns.ViewNetDNS
 	loadAvgView           *loadavg.ViewLoadAvg
 	cpuAvgView            *cpuavg.ViewCPUAvg
 	netTabsView           *nettabs.ViewNetTabs
+	sensorsView           *sensors.ViewSensors
+	netStackView          *netstack.ViewNetStack
+	kernelView            *kernel.ViewKernel
+	interruptsView        *interrupts.ViewInterrupts
+	customView            *custom.ViewCustomMetrics
+	usersView             *users.ViewUsers
+	numaView              *numa.ViewNUMA
+	raidView              *raid.ViewRAID
+	conntrackView         *conntrack.ViewConntrack
+	kernelEventsView      *kernelevents.ViewKernelEvents
+	sysTabsView           *systabs.ViewSysTabs
 	refreshPaused         bool
 	warm                  int
 	receive               int
@@ -68,6 +95,56 @@ func (w *ViewMainWindow) createConnectionView() {
 	w.connectionView.View.SetBorderPadding(1, 0, 0, 1)
 }
 
+// createSystemMetrics создает панель системных метрик с вкладками.
+func (w *ViewMainWindow) createSystemMetrics() *tview.Flex {
+	w.sensorsView = sensors.NewSensorsView()
+	w.netStackView = netstack.NewNetStackView()
+	w.kernelView = kernel.NewKernelView()
+	w.interruptsView = interrupts.NewInterruptsView()
+	w.customView = custom.NewCustomMetricsView()
+	w.usersView = users.NewUsersView()
+	w.numaView = numa.NewNUMAView()
+	w.raidView = raid.NewRAIDView()
+	w.conntrackView = conntrack.NewConntrackView()
+	w.kernelEventsView = kernelevents.NewKernelEventsView()
+
+	pages := tview.NewPages().
+		AddPage("page-0", w.sensorsView.View, true, true).
+		AddPage("page-1", w.netStackView.View, true, false).
+		AddPage("page-2", w.kernelView.View, true, false).
+		AddPage("page-3", w.interruptsView.View, true, false).
+		AddPage("page-4", w.customView.View, true, false).
+		AddPage("page-5", w.usersView.View, true, false).
+		AddPage("page-6", w.numaView.View, true, false).
+		AddPage("page-7", w.raidView.View, true, false).
+		AddPage("page-8", w.conntrackView.View, true, false).
+		AddPage("page-9", w.kernelEventsView.View, true, false)
+
+	w.sysTabsView = systabs.NewSystemTabsView(
+		pages, "Sensors", "Net stack", "Kernel", "Interrupts", "Custom", "Users", "NUMA", "RAID", "Conntrack",
+		"Events",
+	)
+
+	systemMetrics := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(w.sysTabsView.View, 2, 0, false).
+		AddItem(pages, 0, 1, false)
+	systemMetrics.SetBorder(true)
+
+	for _, view := range []*tview.Box{
+		w.sysTabsView.View.Box, w.sensorsView.View.Box, w.netStackView.View.Box, w.kernelView.View.Box,
+		w.interruptsView.View.Box, w.customView.View.Box, w.usersView.View.Box,
+		w.numaView.View.Box, w.raidView.View.Box, w.conntrackView.View.Box, w.kernelEventsView.View.Box,
+	} {
+		view.SetFocusFunc(func() {
+			systemMetrics.SetBorderColor(theme.FocusedBorderColor)
+		})
+		view.SetBlurFunc(func() {
+			systemMetrics.SetBorderColor(theme.UnfocusedBorderColor)
+		})
+	}
+	return systemMetrics
+}
+
 func NewMainView(server, port string, warm, receive int) *ViewMainWindow {
 	v := ViewMainWindow{
 		server:  server,
@@ -117,12 +194,16 @@ func NewMainView(server, port string, warm, receive int) *ViewMainWindow {
 	v.netConnStatesView = netstates.ViewNetConnectionsStates()
 	v.netConnByProtocolView = nettopbyprotocol.NewNetworkConnectionsByProtocolView()
 	v.netConnByClientView = nettopbyconnection.NewNetworkConnectionsByClientView()
+	v.netConnByProcessView = nettopbyprocess.NewNetworkConnectionsByProcessView()
+	v.netDNSView = netdns.NewNetworkDNSView()
 
 	pages := tview.NewPages().
 		AddPage("page-0", v.netConnView.View, true, true).
 		AddPage("page-1", v.netConnStatesView.View, true, false).
 		AddPage("page-2", v.netConnByProtocolView.View, true, false).
-		AddPage("page-3", v.netConnByClientView.View, true, false)
+		AddPage("page-3", v.netConnByClientView.View, true, false).
+		AddPage("page-4", v.netConnByProcessView.View, true, false).
+		AddPage("page-5", v.netDNSView.View, true, false)
 
 	v.netTabsView = nettabs.NewNetworkTabsView(pages)
 
@@ -166,14 +247,29 @@ func NewMainView(server, port string, warm, receive int) *ViewMainWindow {
 		networkMetrics.SetBorderColor(theme.UnfocusedBorderColor)
 	})
 
+	v.netConnByProcessView.View.SetFocusFunc(func() {
+		networkMetrics.SetBorderColor(theme.FocusedBorderColor)
+	})
+	v.netConnByProcessView.View.SetBlurFunc(func() {
+		networkMetrics.SetBorderColor(theme.UnfocusedBorderColor)
+	})
+
+	v.netDNSView.View.SetFocusFunc(func() {
+		networkMetrics.SetBorderColor(theme.FocusedBorderColor)
+	})
+	v.netDNSView.View.SetBlurFunc(func() {
+		networkMetrics.SetBorderColor(theme.UnfocusedBorderColor)
+	})
+
 	mainWindow := tview.NewFlex()
 	mainWindow.
 		AddItem(
 			tview.NewFlex().
 				AddItem(diskMetrics, 0, 2, false).
 				AddItem(networkMetrics, 0, 4, false),
-			0, 1, true,
+			0, 2, true,
 		).
+		AddItem(v.createSystemMetrics(), 0, 1, false).
 		SetDirection(tview.FlexRow).
 		AddItem(statusbar.CreateStatusbar(), 1, 0, false).
 		SetBorderPadding(0, 0, 1, 0)
@@ -201,7 +297,25 @@ func NewMainView(server, port string, warm, receive int) *ViewMainWindow {
 	return &v
 }
 
+// SetQueries учитывает запросы, переданные серверу: списки, порядок которых задан сервером,
+// не сортируются на клиенте.
+func (w *ViewMainWindow) SetQueries(queries []*pb.Query) {
+	for _, q := range queries {
+		ordered := q.OrderBy != ""
+		switch q.Target {
+		case query.TargetDiskIO:
+			w.diskIOView.SetOrdered(ordered)
+		case query.TargetDiskUsage:
+			w.diskUsageView.SetOrdered(ordered)
+		case query.TargetNetTopByConnection:
+			w.netConnByClientView.SetOrdered(ordered)
+		}
+	}
+}
+
 func (w *ViewMainWindow) SetData(data *pb.Snapshot) {
+	w.connectionView.SetData(data.Host, data.Seq)
+	w.kernelEventsView.Add(data.KernelEvents)
 	if w.refreshPaused {
 		return
 	}
@@ -210,8 +324,10 @@ func (w *ViewMainWindow) SetData(data *pb.Snapshot) {
 	w.cpuAvgView.SetData(data.CpuAvg, data.Metrics.CpuAvg)
 	w.diskIOView.SetData(data.DiskIO, data.Metrics.DiskIO)
 	w.diskUsageView.SetData(data.DiskUsage, data.Metrics.DiskUsage)
-	w.netConnByProtocolView.SetData(data.NetTopByProtocol, data.Metrics.NetTopByProtocol)
+	w.netConnByProtocolView.SetData(data.NetTopByProtocol, data.NetTopByApplication, data.Metrics.NetTopByProtocol)
 	w.netConnByClientView.SetData(data.NetTopByConnection, data.Metrics.NetTopByConnection)
+	w.netConnByProcessView.SetData(data.NetTopByProcess, data.Metrics.NetTopByProcess)
+	w.netDNSView.SetData(data.Dns, data.Metrics.Dns)
 	w.netConnStatesView.SetData(data.NetConnectionsStates, data.Metrics.NetConnectionStates)
 	w.netConnView.SetData(data.NetConnections, data.Metrics.NetConnections)
 	w.netTabsView.Update(
@@ -219,5 +335,29 @@ func (w *ViewMainWindow) SetData(data *pb.Snapshot) {
 		data.Metrics.NetConnectionStates,
 		data.Metrics.NetTopByProtocol,
 		data.Metrics.NetTopByConnection,
+		data.Metrics.NetTopByProcess,
+		data.Metrics.Dns,
+	)
+	w.sensorsView.SetData(data.Sensors, data.Metrics.Sensors)
+	w.netStackView.SetData(data.NetStack, data.Metrics.NetStack)
+	w.kernelView.SetData(data.LoadAvg, data.CpuAvg, data.Metrics.LoadAvg, data.Metrics.CpuAvg)
+	w.interruptsView.SetData(data.Interrupts, data.Metrics.Interrupts)
+	w.customView.SetData(data.CustomMetrics, data.Metrics.Plugins)
+	w.usersView.SetData(data.Users, data.Metrics.Users)
+	w.numaView.SetData(data.Numa, data.Metrics.Numa)
+	w.raidView.SetData(data.Raid, data.Metrics.Raid)
+	w.conntrackView.SetData(data.Conntrack, data.Metrics.Conntrack)
+	w.kernelEventsView.SetData(data.Metrics.KernelEvents)
+	w.sysTabsView.Update(
+		data.Metrics.Sensors,
+		data.Metrics.NetStack,
+		data.Metrics.LoadAvg || data.Metrics.CpuAvg,
+		data.Metrics.Interrupts,
+		data.Metrics.Plugins,
+		data.Metrics.Users,
+		data.Metrics.Numa,
+		data.Metrics.Raid,
+		data.Metrics.Conntrack,
+		data.Metrics.KernelEvents,
 	)
 }
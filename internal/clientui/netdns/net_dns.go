@@ -0,0 +1,133 @@
+package netdns
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const colDomainWidth = 40
+
+type ViewNetDNS struct {
+	View         *tview.Flex
+	summary      *tview.TextView
+	domains      *tview.Table
+	types        *tview.Table
+	codes        *tview.Table
+	resolvers    *tview.Table
+	domainCols   []uiutils.Column
+	typeCols     []uiutils.Column
+	codeCols     []uiutils.Column
+	resolverCols []uiutils.Column
+}
+
+func newTable(cols []uiutils.Column) *tview.Table {
+	t := uiutils.CreateTable(cols, "")
+	t.SetBorder(false)
+	t.SetBorders(false)
+	return t
+}
+
+func NewNetworkDNSView() *ViewNetDNS {
+	v := ViewNetDNS{
+		summary: tview.NewTextView().SetDynamicColors(true),
+		domainCols: []uiutils.Column{
+			{Text: "Domain", MaxWidth: colDomainWidth},
+			{Text: "Queries", MaxWidth: 0},
+		},
+		typeCols: []uiutils.Column{
+			{Text: "Type", MaxWidth: 0},
+			{Text: "Queries", MaxWidth: 0},
+		},
+		codeCols: []uiutils.Column{
+			{Text: "RCode", MaxWidth: 0},
+			{Text: "Responses", MaxWidth: 0},
+		},
+		resolverCols: []uiutils.Column{
+			{Text: "Resolver", MaxWidth: 0},
+			{Text: "Queries", MaxWidth: 0},
+			{Text: "Responses", MaxWidth: 0},
+			{Text: "Avg, ms", MaxWidth: 0},
+			{Text: "Max, ms", MaxWidth: 0},
+		},
+	}
+	v.domains = newTable(v.domainCols)
+	v.types = newTable(v.typeCols)
+	v.codes = newTable(v.codeCols)
+	v.resolvers = newTable(v.resolverCols)
+
+	v.View = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(v.summary, 1, 0, false).
+		AddItem(
+			tview.NewFlex().
+				SetDirection(tview.FlexColumn).
+				AddItem(v.domains, 0, 2, true).
+				AddItem(
+					tview.NewFlex().
+						SetDirection(tview.FlexRow).
+						AddItem(v.types, 0, 1, false).
+						AddItem(v.codes, 0, 1, false),
+					0, 1, false,
+				).
+				AddItem(v.resolvers, 0, 2, false),
+			0, 1, true,
+		)
+	v.View.SetBorder(false)
+	return &v
+}
+
+func setHeader(t *tview.Table, cols []uiutils.Column) {
+	for idx, column := range cols {
+		t.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+}
+
+func setCounters(t *tview.Table, cols []uiutils.Column, data []*pb.DnsCounter) {
+	setHeader(t, cols)
+	for i, d := range data {
+		t.SetCell(i+1, 0, uiutils.CreateCell(d.Name, cols[0].MaxWidth, tview.AlignLeft))
+		t.SetCell(i+1, 1, uiutils.CreateCell(fmt.Sprintf("%d", d.Count), 0, tview.AlignLeft))
+	}
+	t.SetFixed(1, 0)
+	t.ScrollToBeginning()
+}
+
+func (v *ViewNetDNS) SetData(data *pb.DnsStat, enabled bool) {
+	v.summary.Clear()
+	v.domains.Clear()
+	v.types.Clear()
+	v.codes.Clear()
+	v.resolvers.Clear()
+
+	if !enabled || data == nil {
+		return
+	}
+
+	uiutils.Str(v.summary, "Queries: %d  Responses: %d  NXDOMAIN: %.2f%%  SERVFAIL: %.2f%%",
+		data.Queries,
+		data.Responses,
+		utils.RoundFloat(data.NxdomainPercent, 2),
+		utils.RoundFloat(data.ServfailPercent, 2),
+	)
+
+	setCounters(v.domains, v.domainCols, data.TopDomains)
+	setCounters(v.types, v.typeCols, data.QueryTypes)
+	setCounters(v.codes, v.codeCols, data.ResponseCodes)
+
+	setHeader(v.resolvers, v.resolverCols)
+	for i, d := range data.Resolvers {
+		v.resolvers.SetCell(i+1, 0, uiutils.CreateCell(d.Ip, 0, tview.AlignLeft))
+		v.resolvers.SetCell(i+1, 1, uiutils.CreateCell(fmt.Sprintf("%d", d.Queries), 0, tview.AlignLeft))
+		v.resolvers.SetCell(i+1, 2, uiutils.CreateCell(fmt.Sprintf("%d", d.Responses), 0, tview.AlignLeft))
+		s := fmt.Sprintf("%.2f", utils.RoundFloat(d.AvgLatencyMs, 2))
+		v.resolvers.SetCell(i+1, 3, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		s = fmt.Sprintf("%.2f", utils.RoundFloat(d.MaxLatencyMs, 2))
+		v.resolvers.SetCell(i+1, 4, uiutils.CreateCell(s, 0, tview.AlignLeft))
+	}
+	v.resolvers.SetFixed(1, 0)
+	v.resolvers.ScrollToBeginning()
+}
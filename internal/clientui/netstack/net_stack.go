@@ -0,0 +1,96 @@
+package netstack
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+type counter struct {
+	name  string
+	value float64
+	// alert - ненулевое значение говорит о потерях и выделяется цветом
+	alert bool
+}
+
+type gauge struct {
+	name  string
+	value string
+}
+
+type ViewNetStack struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewNetStackView() *ViewNetStack {
+	cols := []uiutils.Column{
+		{Text: "Counter", MaxWidth: 0},
+		{Text: "Per sec", MaxWidth: 0},
+		{Text: "Sockets", MaxWidth: 0},
+		{Text: "Value", MaxWidth: 0},
+	}
+	v := ViewNetStack{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func (v *ViewNetStack) SetData(data *pb.NetStack, enabled bool) {
+	v.View.Clear()
+
+	if !enabled || data == nil {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	counters := []counter{
+		{"TCP retransmits", data.TcpRetransSegs, false},
+		{"TCP resets sent", data.TcpOutRsts, false},
+		{"TCP established resets", data.TcpEstabResets, false},
+		{"TCP failed attempts", data.TcpAttemptFails, false},
+		{"TCP input errors", data.TcpInErrs, true},
+		{"Listen overflows", data.ListenOverflows, true},
+		{"Listen drops", data.ListenDrops, true},
+		{"UDP receive buffer errors", data.UdpRcvbufErrors, true},
+		{"UDP send buffer errors", data.UdpSndbufErrors, true},
+		{"UDP input errors", data.UdpInErrors, true},
+		{"ICMP input errors", data.IcmpInErrors, false},
+		{"ICMP output errors", data.IcmpOutErrors, false},
+	}
+	gauges := []gauge{
+		{"Sockets used", fmt.Sprint(data.SocketsUsed)},
+		{"TCP in use", fmt.Sprint(data.TcpInUse)},
+		{"TCP6 in use", fmt.Sprint(data.Tcp6InUse)},
+		{"TCP orphaned", fmt.Sprint(data.TcpOrphan)},
+		{"TCP time wait", fmt.Sprint(data.TcpTimeWait)},
+		{"TCP allocated", fmt.Sprint(data.TcpAlloc)},
+		{"TCP memory", humanize.Bytes(data.TcpMemBytes)},
+		{"UDP in use", fmt.Sprint(data.UdpInUse)},
+		{"UDP6 in use", fmt.Sprint(data.Udp6InUse)},
+		{"UDP memory", humanize.Bytes(data.UdpMemBytes)},
+	}
+
+	for i, c := range counters {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(c.name, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(fmt.Sprint(utils.RoundFloat(c.value, 2)), 0, tview.AlignLeft))
+		if c.alert && c.value > 0 {
+			v.View.GetCell(i+1, 0).SetTextColor(theme.AlertColor)
+			v.View.GetCell(i+1, 1).SetTextColor(theme.AlertColor)
+		}
+	}
+	for i, g := range gauges {
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(g.name, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(g.value, 0, tview.AlignLeft))
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -1,35 +1,19 @@
 package nettabs
 
 import (
-	"fmt"
-
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
-	"github.com/skushnerchuk/simda/internal/clientui/theme"
 	"github.com/skushnerchuk/simda/internal/clientui/utils"
 )
 
-func createTab(index, title string, enabled bool) string {
-	// ["%s"][white]%s[black][""]
-	s := "🟢"
-	if !enabled {
-		s = "🔴"
-	}
-	return fmt.Sprintf(`["%s"][%s] %s%s [%s][""]`,
-		index,
-		theme.TabBackgroundColor,
-		s,
-		title,
-		theme.TabColor,
-	)
-}
-
 type ViewNetTabs struct {
 	View                *tview.TextView
 	tabConnection       string
 	tabState            string
 	tabTopByProtocol    string
 	tabTopByConnections string
+	tabTopByProcesses   string
+	tabDNS              string
 }
 
 func NewNetworkTabsView(pages *tview.Pages) *ViewNetTabs {
@@ -44,15 +28,19 @@ func NewNetworkTabsView(pages *tview.Pages) *ViewNetTabs {
 	v.View.SetBackgroundColor(tcell.ColorBlack)
 	v.View.SetBorder(false)
 
-	v.tabConnection = createTab("0", "Connections", true)
-	v.tabState = createTab("1", "States", true)
-	v.tabTopByProtocol = createTab("2", "Top by protocols", true)
-	v.tabTopByConnections = createTab("3", "Top by connections", true)
+	v.tabConnection = utils.CreateTab("0", "Connections", true)
+	v.tabState = utils.CreateTab("1", "States", true)
+	v.tabTopByProtocol = utils.CreateTab("2", "Top by protocols", true)
+	v.tabTopByConnections = utils.CreateTab("3", "Top by connections", true)
+	v.tabTopByProcesses = utils.CreateTab("4", "Top by processes", true)
+	v.tabDNS = utils.CreateTab("5", "DNS", true)
 
 	utils.Str(v.View, v.tabConnection)
 	utils.Str(v.View, v.tabState)
 	utils.Str(v.View, v.tabTopByProtocol)
 	utils.Str(v.View, v.tabTopByConnections)
+	utils.Str(v.View, v.tabTopByProcesses)
+	utils.Str(v.View, v.tabDNS)
 
 	v.View.SetHighlightedFunc(func(added, _, _ []string) {
 		if len(added) > 0 {
@@ -66,12 +54,15 @@ func NewNetworkTabsView(pages *tview.Pages) *ViewNetTabs {
 }
 
 func (v *ViewNetTabs) Update(
-	connectionsEnabled, statesEnabled, topByProtocolEnabled, topByConnectionsEnabled bool,
+	connectionsEnabled, statesEnabled, topByProtocolEnabled, topByConnectionsEnabled, topByProcessesEnabled,
+	dnsEnabled bool,
 ) {
-	v.tabConnection = createTab("0", "Connections", connectionsEnabled)
-	v.tabState = createTab("1", "States", statesEnabled)
-	v.tabTopByProtocol = createTab("2", "Top by protocols", topByProtocolEnabled)
-	v.tabTopByConnections = createTab("3", "Top by connections", topByConnectionsEnabled)
+	v.tabConnection = utils.CreateTab("0", "Connections", connectionsEnabled)
+	v.tabState = utils.CreateTab("1", "States", statesEnabled)
+	v.tabTopByProtocol = utils.CreateTab("2", "Top by protocols", topByProtocolEnabled)
+	v.tabTopByConnections = utils.CreateTab("3", "Top by connections", topByConnectionsEnabled)
+	v.tabTopByProcesses = utils.CreateTab("4", "Top by processes", topByProcessesEnabled)
+	v.tabDNS = utils.CreateTab("5", "DNS", dnsEnabled)
 
 	v.View.Clear()
 
@@ -79,4 +70,6 @@ func (v *ViewNetTabs) Update(
 	utils.Str(v.View, v.tabState)
 	utils.Str(v.View, v.tabTopByProtocol)
 	utils.Str(v.View, v.tabTopByConnections)
+	utils.Str(v.View, v.tabTopByProcesses)
+	utils.Str(v.View, v.tabDNS)
 }
@@ -11,8 +11,9 @@ import (
 )
 
 type ViewNetConnectionsByClient struct {
-	View *tview.Table
-	cols []uiutils.Column
+	View    *tview.Table
+	cols    []uiutils.Column
+	ordered bool
 }
 
 func NewNetworkConnectionsByClientView() *ViewNetConnectionsByClient {
@@ -29,6 +30,10 @@ func NewNetworkConnectionsByClientView() *ViewNetConnectionsByClient {
 	return &v
 }
 
+func (v *ViewNetConnectionsByClient) SetOrdered(ordered bool) {
+	v.ordered = ordered
+}
+
 func (v *ViewNetConnectionsByClient) SetData(data []*pb.NetTopByConnection, enabled bool) {
 	v.View.Clear()
 
@@ -36,7 +41,9 @@ func (v *ViewNetConnectionsByClient) SetData(data []*pb.NetTopByConnection, enab
 		return
 	}
 
-	sort.Slice(data, func(i, j int) bool { return data[i].Percent > data[j].Percent })
+	if !v.ordered {
+		sort.Slice(data, func(i, j int) bool { return data[i].Percent > data[j].Percent })
+	}
 
 	for idx, column := range v.cols {
 		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
@@ -0,0 +1,65 @@
+package nettopbyprocess
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const (
+	colUserWidth    = 12
+	colCommandWidth = 50
+)
+
+type ViewNetConnectionsByProcess struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewNetworkConnectionsByProcessView() *ViewNetConnectionsByProcess {
+	cols := []uiutils.Column{
+		{Text: "PID", MaxWidth: 0},
+		{Text: "Command", MaxWidth: colCommandWidth},
+		{Text: "User", MaxWidth: colUserWidth},
+		{Text: "Rx/s", MaxWidth: 0},
+		{Text: "Tx/s", MaxWidth: 0},
+	}
+	v := ViewNetConnectionsByProcess{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func (v *ViewNetConnectionsByProcess) SetData(data []*pb.NetTopByProcess, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].RxBytesPerSec+data[i].TxBytesPerSec > data[j].RxBytesPerSec+data[j].TxBytesPerSec
+	})
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		pid := "-"
+		if d.Pid != 0 {
+			pid = fmt.Sprintf("%d", d.Pid)
+		}
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(pid, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(d.Command, colCommandWidth, tview.AlignLeft))
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(d.User, colUserWidth, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(humanize.Bytes(uint64(d.RxBytesPerSec)), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 4, uiutils.CreateCell(humanize.Bytes(uint64(d.TxBytesPerSec)), 0, tview.AlignLeft))
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -5,14 +5,18 @@ import (
 	"sort"
 
 	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
 	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"github.com/skushnerchuk/simda/internal/utils"
 )
 
 type ViewNetConnectionsByProtocol struct {
-	View *tview.Table
-	cols []uiutils.Column
+	View            *tview.Flex
+	protocols       *tview.Table
+	applications    *tview.Table
+	cols            []uiutils.Column
+	applicationCols []uiutils.Column
 }
 
 func NewNetworkConnectionsByProtocolView() *ViewNetConnectionsByProtocol {
@@ -21,34 +25,86 @@ func NewNetworkConnectionsByProtocolView() *ViewNetConnectionsByProtocol {
 		{Text: "Bytes", MaxWidth: 0},
 		{Text: "Percent", MaxWidth: 0},
 	}
-	v := ViewNetConnectionsByProtocol{View: uiutils.CreateTable(cols, ""), cols: cols}
+	applicationCols := []uiutils.Column{
+		{Text: "Application", MaxWidth: 0},
+		{Text: "L4", MaxWidth: 0},
+		{Text: "Bytes", MaxWidth: 0},
+		{Text: "Percent", MaxWidth: 0},
+	}
+	v := ViewNetConnectionsByProtocol{
+		protocols:       uiutils.CreateTable(cols, ""),
+		applications:    uiutils.CreateTable(applicationCols, ""),
+		cols:            cols,
+		applicationCols: applicationCols,
+	}
+	v.protocols.SetBorder(false)
+	v.protocols.SetBorders(false)
+	v.applications.SetBorder(false)
+	v.applications.SetBorders(false)
+
+	v.View = tview.NewFlex().
+		SetDirection(tview.FlexColumn).
+		AddItem(v.protocols, 0, 1, true).
+		AddItem(v.applications, 0, 1, false)
 	v.View.SetBorder(false)
-	v.View.SetBorders(false)
 	return &v
 }
 
-func (v *ViewNetConnectionsByProtocol) SetData(data []*pb.NetTopByProtocol, enabled bool) {
-	v.View.Clear()
+func (v *ViewNetConnectionsByProtocol) SetData(
+	data []*pb.NetTopByProtocol, applications []*pb.NetTopByApplication, enabled bool,
+) {
+	v.protocols.Clear()
+	v.applications.Clear()
 
 	if !enabled {
 		return
 	}
 
+	v.setProtocols(data)
+	v.setApplications(applications)
+}
+
+func (v *ViewNetConnectionsByProtocol) setProtocols(data []*pb.NetTopByProtocol) {
 	sort.Slice(data, func(i, j int) bool { return data[i].Percent > data[j].Percent })
 
 	for idx, column := range v.cols {
-		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+		v.protocols.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		v.protocols.SetCell(i+1, 0, uiutils.CreateCell(d.Protocol, 0, tview.AlignLeft))
+
+		s := fmt.Sprintf("%d", d.Bytes)
+		v.protocols.SetCell(i+1, 1, uiutils.CreateCell(s, 0, tview.AlignLeft))
+
+		s = fmt.Sprintf("%.2f", utils.RoundFloat(d.Percent, 2))
+		v.protocols.SetCell(i+1, 2, uiutils.CreateCell(s, 0, tview.AlignLeft))
+
+		if uiutils.Anomalous(d.Anomalies, "bytes_per_sec") {
+			v.protocols.GetCell(i+1, 1).SetTextColor(theme.AlertColor)
+		}
+	}
+	v.protocols.SetFixed(1, 0)
+	v.protocols.ScrollToBeginning()
+}
+
+func (v *ViewNetConnectionsByProtocol) setApplications(data []*pb.NetTopByApplication) {
+	sort.Slice(data, func(i, j int) bool { return data[i].Percent > data[j].Percent })
+
+	for idx, column := range v.applicationCols {
+		v.applications.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
 	}
 
 	for i, d := range data {
-		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Protocol, 0, tview.AlignLeft))
+		v.applications.SetCell(i+1, 0, uiutils.CreateCell(d.Application, 0, tview.AlignLeft))
+		v.applications.SetCell(i+1, 1, uiutils.CreateCell(d.Protocol, 0, tview.AlignLeft))
 
 		s := fmt.Sprintf("%d", d.Bytes)
-		v.View.SetCell(i+1, 1, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		v.applications.SetCell(i+1, 2, uiutils.CreateCell(s, 0, tview.AlignLeft))
 
 		s = fmt.Sprintf("%.2f", utils.RoundFloat(d.Percent, 2))
-		v.View.SetCell(i+1, 2, uiutils.CreateCell(s, 0, tview.AlignLeft))
+		v.applications.SetCell(i+1, 3, uiutils.CreateCell(s, 0, tview.AlignLeft))
 	}
-	v.View.SetFixed(1, 0)
-	v.View.ScrollToBeginning()
+	v.applications.SetFixed(1, 0)
+	v.applications.ScrollToBeginning()
 }
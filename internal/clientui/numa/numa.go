@@ -0,0 +1,101 @@
+package numa
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+type ViewNUMA struct {
+	View     *tview.Table
+	cols     []uiutils.Column
+	hugeCols []uiutils.Column
+}
+
+func NewNUMAView() *ViewNUMA {
+	cols := []uiutils.Column{
+		{Text: "Node", MaxWidth: 0},
+		{Text: "Total", MaxWidth: 0},
+		{Text: "Used", MaxWidth: 0},
+		{Text: "Free", MaxWidth: 0},
+		{Text: "Hit/s", MaxWidth: 0},
+		{Text: "Miss/s", MaxWidth: 0},
+		{Text: "Foreign/s", MaxWidth: 0},
+		{Text: "Other node/s", MaxWidth: 0},
+	}
+	hugeCols := []uiutils.Column{
+		{Text: "Hugepage", MaxWidth: 0},
+		{Text: "Total", MaxWidth: 0},
+		{Text: "Free", MaxWidth: 0},
+		{Text: "Reserved", MaxWidth: 0},
+		{Text: "Surplus", MaxWidth: 0},
+	}
+	v := ViewNUMA{View: uiutils.CreateTable(cols, ""), cols: cols, hugeCols: hugeCols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatRate(value float64) string {
+	return fmt.Sprint(utils.RoundFloat(value, 1))
+}
+
+// SetData выводит узлы NUMA и пулы больших страниц под ними. Выделение на чужих узлах
+// и исчерпанные пулы выделяются цветом.
+func (v *ViewNUMA) SetData(data *pb.Numa, enabled bool) {
+	v.View.Clear()
+
+	if !enabled || data == nil {
+		return
+	}
+
+	row := 0
+	for idx, column := range v.cols {
+		v.View.SetCell(row, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+	for _, d := range data.Nodes {
+		row++
+		values := []string{
+			fmt.Sprint(d.Node), humanize.Bytes(d.MemTotal), humanize.Bytes(d.MemUsed), humanize.Bytes(d.MemFree),
+			formatRate(d.NumaHit), formatRate(d.NumaMiss), formatRate(d.NumaForeign), formatRate(d.OtherNode),
+		}
+		for col, value := range values {
+			v.View.SetCell(row, col, uiutils.CreateCell(value, 0, tview.AlignLeft))
+		}
+		if d.NumaMiss > 0 || d.NumaForeign > 0 {
+			for col := range values {
+				v.View.GetCell(row, col).SetTextColor(theme.AlertColor)
+			}
+		}
+	}
+
+	if len(data.HugePages) > 0 {
+		row += 2
+		for idx, column := range v.hugeCols {
+			v.View.SetCell(row, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+		}
+	}
+	for _, d := range data.HugePages {
+		row++
+		values := []string{
+			humanize.IBytes(d.SizeBytes), fmt.Sprint(d.Total), fmt.Sprint(d.Free), fmt.Sprint(d.Reserved),
+			fmt.Sprint(d.Surplus),
+		}
+		for col, value := range values {
+			v.View.SetCell(row, col, uiutils.CreateCell(value, 0, tview.AlignLeft))
+		}
+		// Пул настроен, но свободных страниц не осталось
+		if d.Total > 0 && d.Free <= d.Reserved {
+			for col := range values {
+				v.View.GetCell(row, col).SetTextColor(theme.AlertColor)
+			}
+		}
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -0,0 +1,95 @@
+package raid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+type ViewRAID struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewRAIDView() *ViewRAID {
+	cols := []uiutils.Column{
+		{Text: "Array", MaxWidth: 0},
+		{Text: "Level", MaxWidth: 0},
+		{Text: "State", MaxWidth: 0},
+		{Text: "Disks", MaxWidth: 0},
+		{Text: "Sync", MaxWidth: 0},
+		{Text: "Members", MaxWidth: 0},
+	}
+	v := ViewRAID{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+// formatSync возвращает ход синхронизации массива: действие, процент и оставшееся время.
+func formatSync(d *pb.RaidArray) string {
+	if d.SyncAction == "" {
+		return "-"
+	}
+	if d.SyncProgress == 0 {
+		return d.SyncAction
+	}
+	s := fmt.Sprintf("%s %.1f%%", d.SyncAction, utils.RoundFloat(d.SyncProgress, 1))
+	if d.SyncFinish > 0 {
+		s += " " + (time.Duration(d.SyncFinish) * time.Second).String()
+	}
+	return s
+}
+
+func formatMembers(members []*pb.RaidMember) string {
+	result := make([]string, 0, len(members))
+	for _, m := range members {
+		s := m.Device
+		if m.Disk != "" && m.Disk != m.Device {
+			s += "@" + m.Disk
+		}
+		if m.State != "in_sync" {
+			s += "(" + m.State + ")"
+		}
+		result = append(result, s)
+	}
+	return strings.Join(result, " ")
+}
+
+// SetData выводит программные RAID. Деградировавшие массивы выделяются цветом.
+func (v *ViewRAID) SetData(data []*pb.RaidArray, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+	for i, d := range data {
+		state := d.State
+		if d.Degraded {
+			state += " degraded"
+		}
+		values := []string{
+			d.Name, d.Level, state, fmt.Sprintf("%d/%d", d.DisksActive, d.DisksTotal), formatSync(d),
+			formatMembers(d.Members),
+		}
+		for col, value := range values {
+			cell := uiutils.CreateCell(value, 0, tview.AlignLeft)
+			if d.Degraded {
+				cell.SetTextColor(theme.AlertColor)
+			}
+			v.View.SetCell(i+1, col, cell)
+		}
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
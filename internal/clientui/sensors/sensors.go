@@ -0,0 +1,78 @@
+package sensors
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const colLabelWidth = 24
+
+var units = map[string]string{
+	"temperature": "°C",
+	"fan":         "RPM",
+	"voltage":     "V",
+}
+
+type ViewSensors struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewSensorsView() *ViewSensors {
+	cols := []uiutils.Column{
+		{Text: "Chip", MaxWidth: 0},
+		{Text: "Sensor", MaxWidth: colLabelWidth},
+		{Text: "Value", MaxWidth: 0},
+		{Text: "Max", MaxWidth: 0},
+		{Text: "Critical", MaxWidth: 0},
+	}
+	v := ViewSensors{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatValue(value float64, kind string) string {
+	if value == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f %s", utils.RoundFloat(value, 2), units[kind])
+}
+
+func (v *ViewSensors) SetData(data []*pb.Sensor, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.Chip, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(d.Label, colLabelWidth, tview.AlignLeft))
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(formatValue(d.Value, d.Kind), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(formatValue(d.Max, d.Kind), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 4, uiutils.CreateCell(formatValue(d.Critical, d.Kind), 0, tview.AlignLeft))
+
+		// Датчики, достигшие порога, выделяются цветом
+		limit := d.Max
+		if limit == 0 {
+			limit = d.Critical
+		}
+		if limit > 0 && d.Value >= limit {
+			for col := range v.cols {
+				v.View.GetCell(i+1, col).SetTextColor(theme.AlertColor)
+			}
+		}
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -0,0 +1,53 @@
+package systabs
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skushnerchuk/simda/internal/clientui/utils"
+)
+
+// ViewSysTabs - вкладки панели системных метрик. Вкладка с индексом N переключает страницу page-N.
+type ViewSysTabs struct {
+	View   *tview.TextView
+	titles []string
+}
+
+func NewSystemTabsView(pages *tview.Pages, titles ...string) *ViewSysTabs {
+	v := ViewSysTabs{
+		View:   tview.NewTextView(),
+		titles: titles,
+	}
+
+	v.View.SetTextAlign(tview.AlignLeft).
+		SetWrap(false).
+		SetRegions(true).
+		SetDynamicColors(true)
+	v.View.SetBackgroundColor(tcell.ColorBlack)
+	v.View.SetBorder(false)
+
+	enabled := make([]bool, len(titles))
+	for i := range enabled {
+		enabled[i] = true
+	}
+	v.Update(enabled...)
+
+	v.View.SetHighlightedFunc(func(added, _, _ []string) {
+		if len(added) > 0 {
+			pages.SwitchToPage("page-" + added[0])
+		} else {
+			v.View.Highlight("0")
+		}
+	})
+	v.View.Highlight("0")
+	return &v
+}
+
+// Update перерисовывает вкладки, состояние метрик передается в порядке вкладок.
+func (v *ViewSysTabs) Update(enabled ...bool) {
+	v.View.Clear()
+	for i, title := range v.titles {
+		utils.Str(v.View, utils.CreateTab(strconv.Itoa(i), title, i < len(enabled) && enabled[i]))
+	}
+}
@@ -25,6 +25,7 @@ const (
 	UnfocusedBorderColor = tcell.ColorWhite
 	TabColor             = tcell.ColorBlack
 	TabBackgroundColor   = tcell.ColorGray
+	AlertColor           = tcell.ColorRed
 )
 
 func ApplyTheme() {
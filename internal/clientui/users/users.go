@@ -0,0 +1,67 @@
+package users
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+	uiutils "github.com/skushnerchuk/simda/internal/clientui/utils"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const colPortsWidth = 30
+
+type ViewUsers struct {
+	View *tview.Table
+	cols []uiutils.Column
+}
+
+func NewUsersView() *ViewUsers {
+	cols := []uiutils.Column{
+		{Text: "User", MaxWidth: 0},
+		{Text: "UID", MaxWidth: 0},
+		{Text: "Procs", MaxWidth: 0},
+		{Text: "CPU %", MaxWidth: 0},
+		{Text: "RSS", MaxWidth: 0},
+		{Text: "Conns", MaxWidth: 0},
+		{Text: "Listen ports", MaxWidth: colPortsWidth},
+	}
+	v := ViewUsers{View: uiutils.CreateTable(cols, ""), cols: cols}
+	v.View.SetBorder(false)
+	v.View.SetBorders(false)
+	return &v
+}
+
+func formatPorts(ports []uint32) string {
+	items := make([]string, 0, len(ports))
+	for _, p := range ports {
+		items = append(items, fmt.Sprint(p))
+	}
+	return strings.Join(items, ",")
+}
+
+func (v *ViewUsers) SetData(data []*pb.UserUsage, enabled bool) {
+	v.View.Clear()
+
+	if !enabled {
+		return
+	}
+
+	for idx, column := range v.cols {
+		v.View.SetCell(0, idx, uiutils.CreateHeaderCell(column.Text, column.MaxWidth, tview.AlignLeft))
+	}
+
+	for i, d := range data {
+		v.View.SetCell(i+1, 0, uiutils.CreateCell(d.User, 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 1, uiutils.CreateCell(fmt.Sprint(d.Uid), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 2, uiutils.CreateCell(fmt.Sprint(d.Processes), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 3, uiutils.CreateCell(fmt.Sprint(utils.RoundFloat(d.CpuPercent, 2)), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 4, uiutils.CreateCell(humanize.Bytes(d.RssBytes), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 5, uiutils.CreateCell(fmt.Sprint(d.Connections), 0, tview.AlignLeft))
+		v.View.SetCell(i+1, 6, uiutils.CreateCell(formatPorts(d.ListenPorts), colPortsWidth, tview.AlignLeft))
+	}
+	v.View.SetFixed(1, 0)
+	v.View.ScrollToBeginning()
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/skushnerchuk/simda/internal/clientui/theme"
+)
+
+// CreateTab возвращает разметку вкладки с признаком включенной метрики.
+func CreateTab(index, title string, enabled bool) string {
+	s := "🟢"
+	if !enabled {
+		s = "🔴"
+	}
+	return fmt.Sprintf(`["%s"][%s] %s%s [%s][""]`,
+		index,
+		theme.TabBackgroundColor,
+		s,
+		title,
+		theme.TabColor,
+	)
+}
@@ -14,3 +14,13 @@ func Str(w io.Writer, s string, a ...any) {
 func AddrToString(addr *pb.SockAddr) string {
 	return fmt.Sprintf("%s:%d", addr.Ip, addr.Port)
 }
+
+// Anomalous сообщает, что детектор аномалий демона пометил значение поля field как аномальное.
+func Anomalous(scores []*pb.AnomalyScore, field string) bool {
+	for _, s := range scores {
+		if s.Field == field {
+			return s.Anomalous
+		}
+	}
+	return false
+}
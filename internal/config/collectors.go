@@ -0,0 +1,28 @@
+package config
+
+// Имена сборщиков метрик. Используются как ключи Intervals, имена метрик в реестре и имена сборщиков
+// в правилах оповещений и моделях аномалий.
+const (
+	CollectorLoadAvg        = "load_avg"
+	CollectorCPUAvg         = "cpu_avg"
+	CollectorDiskIO         = "disk_io"
+	CollectorDiskUsage      = "disk_usage"
+	CollectorNetConnections = "net_connections"
+	CollectorNetPackets     = "net_packets"
+	CollectorSensors        = "sensors"
+	CollectorNetStack       = "net_stack"
+	CollectorInterrupts     = "interrupts"
+	CollectorPlugins        = "plugins"
+	CollectorUsers          = "users"
+	CollectorNUMA           = "numa"
+	CollectorRAID           = "raid"
+	CollectorConntrack      = "conntrack"
+	CollectorKernelEvents   = "kernel_events"
+)
+
+// Collectors - имена всех сборщиков метрик.
+var Collectors = []string{
+	CollectorLoadAvg, CollectorCPUAvg, CollectorDiskIO, CollectorDiskUsage, CollectorNetConnections,
+	CollectorNetPackets, CollectorSensors, CollectorNetStack, CollectorInterrupts, CollectorPlugins,
+	CollectorUsers, CollectorNUMA, CollectorRAID, CollectorConntrack, CollectorKernelEvents,
+}
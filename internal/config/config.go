@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
@@ -20,6 +23,17 @@ type Metrics struct {
 	NetConnectionsStates bool `mapstructure:"net_connections_states"`
 	NetTopByProtocol     bool `mapstructure:"net_top_by_protocol"`
 	NetTopByClients      bool `mapstructure:"net_top_by_connection"`
+	NetTopByProcess      bool `mapstructure:"net_top_by_process"`
+	DNS                  bool `mapstructure:"dns"`
+	Sensors              bool `mapstructure:"sensors"`
+	NetStack             bool `mapstructure:"net_stack"`
+	Interrupts           bool `mapstructure:"interrupts"`
+	Plugins              bool `mapstructure:"plugins"`
+	Users                bool `mapstructure:"users"`
+	NUMA                 bool `mapstructure:"numa"`
+	RAID                 bool `mapstructure:"raid"`
+	Conntrack            bool `mapstructure:"conntrack"`
+	KernelEvents         bool `mapstructure:"kernel_events"`
 }
 
 type SystemPoints struct {
@@ -33,14 +47,132 @@ type SystemPoints struct {
 	UDP6          string `mapstructure:"udp6"`
 	ProcMountInfo string `mapstructure:"procMountInfo"`
 	Interface     string `mapstructure:"interface"`
+	Kmsg          string `mapstructure:"kmsg"`
 }
 
+// Service - сопоставление порта прикладному протоколу.
+type Service struct {
+	Name     string `mapstructure:"name"`
+	Protocol string `mapstructure:"protocol"`
+	Port     uint16 `mapstructure:"port"`
+}
+
+// DiskUsageFilter - правила отбора точек монтирования для метрики использования дисков.
+// Значения - шаблоны в формате filepath.Match, шаблон точки монтирования с подстановками покрывает
+// и вложенные пути. Если список include пуст, проходят все значения.
+type DiskUsageFilter struct {
+	IncludeMountpoints []string `mapstructure:"include_mountpoints"`
+	ExcludeMountpoints []string `mapstructure:"exclude_mountpoints"`
+	IncludeDevices     []string `mapstructure:"include_devices"`
+	ExcludeDevices     []string `mapstructure:"exclude_devices"`
+	IncludeFsTypes     []string `mapstructure:"include_fs_types"`
+	ExcludeFsTypes     []string `mapstructure:"exclude_fs_types"`
+}
+
+// Forecast - параметры прогноза заполнения файловых систем.
+// Windows - окна, по которым оценивается скорость роста, Threshold - порог, при котором
+// файловая система помечается как заполняющаяся.
+type Forecast struct {
+	Windows   []time.Duration `mapstructure:"windows"`
+	Threshold time.Duration   `mapstructure:"threshold"`
+}
+
+// Interrupts - параметры поиска дисбаланса прерываний. Дисбаланс фиксируется, если один процессор
+// обрабатывает больше ImbalancePercent процентов прерываний устройства при скорости не меньше MinRate в секунду.
+type Interrupts struct {
+	ImbalancePercent float64 `mapstructure:"imbalance_percent"`
+	MinRate          float64 `mapstructure:"min_rate"`
+}
+
+// Conntrack - параметры метрики таблицы отслеживания соединений. Entries включает разбивку записей
+// по протоколам и состояниям, для которой на каждом измерении читается вся таблица.
+type Conntrack struct {
+	Entries bool `mapstructure:"entries"`
+}
+
+// Plugin - внешняя команда, выводящая метрики в формате json, prometheus или influx.
+// Команда запускается с периодом Interval и принудительно завершается по истечении Timeout.
+type Plugin struct {
+	Name     string        `mapstructure:"name"`
+	Command  []string      `mapstructure:"command"`
+	Format   string        `mapstructure:"format"`
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// AlertRule - правило оповещения. Expr - условие вида "cpu.user > 90 for 60s" или "disk_usage{mount=/} > 85",
+// Severity передается в оповещении без изменений.
+type AlertRule struct {
+	Name     string `mapstructure:"name"`
+	Expr     string `mapstructure:"expr"`
+	Severity string `mapstructure:"severity"`
+}
+
+// Alerts - правила оповещений. Правила проверяются с периодом Interval независимо от подключенных клиентов.
+// Оповещения о срабатывании и разрешении отправляются методом POST на адреса Webhooks, неудачная отправка
+// повторяется до Retries раз, пауза между попытками начинается с RetryDelay и удваивается.
+type Alerts struct {
+	Rules      []AlertRule   `mapstructure:"rules"`
+	Interval   time.Duration `mapstructure:"interval"`
+	Webhooks   []string      `mapstructure:"webhooks"`
+	Timeout    time.Duration `mapstructure:"timeout"`
+	Retries    int           `mapstructure:"retries"`
+	RetryDelay time.Duration `mapstructure:"retry_delay"`
+}
+
+// MaxAlertInterval - наибольший период проверки правил оповещений, совпадает с наибольшим окном снимка.
+const MaxAlertInterval = 120 * time.Second
+
+// AnomalyDetector - детектор аномалий метрики. Метод ewma сравнивает значение с экспоненциально взвешенным
+// средним и дисперсией с коэффициентом сглаживания Alpha, метод seasonal - с медианой и MAD значений, попавших
+// в тот же интервал сезона Season, разбитого на Buckets интервалов. Значение, оценка которого по модулю
+// не меньше Threshold, помечается как аномальное. Оценки не передаются, пока модель не получила Warmup измерений.
+// MinSpread - наименьший разброс в единицах метрики, не дает почти постоянному ряду отмечать малые отклонения.
+type AnomalyDetector struct {
+	Method    string        `mapstructure:"method"`
+	Alpha     float64       `mapstructure:"alpha"`
+	Season    time.Duration `mapstructure:"season"`
+	Buckets   int           `mapstructure:"buckets"`
+	Threshold float64       `mapstructure:"threshold"`
+	Warmup    int           `mapstructure:"warmup"`
+	MinSpread float64       `mapstructure:"min_spread"`
+}
+
+// Anomaly - обнаружение аномалий. Ключ Metrics - имя метрики: load_avg, cpu_avg, disk_io или traffic.
+// Модели обучаются с периодом Interval независимо от подключенных клиентов, незаданные параметры
+// детектора получают значения по умолчанию.
+type Anomaly struct {
+	Interval time.Duration              `mapstructure:"interval"`
+	Metrics  map[string]AnomalyDetector `mapstructure:"metrics"`
+}
+
+// DefaultInterval - период измерений сборщика, для которого интервал не задан в настройках.
+const DefaultInterval = time.Second
+
+// MinInterval - минимальный допустимый период измерений сборщика.
+const MinInterval = 100 * time.Millisecond
+
+// Intervals - периоды измерений сборщиков. Ключ - имя сборщика из Collectors.
+// Для сборщиков, которых нет в списке, используется DefaultInterval.
+type Intervals map[string]time.Duration
+
 type DaemonConfig struct {
-	Host     string       `mapstructure:"host"`
-	Port     string       `mapstructure:"port"`
-	Metrics  Metrics      `mapstructure:"metrics"`
-	System   SystemPoints `mapstructure:"system"`
-	LogLevel string       `mapstructure:"log_level"`
+	Host         string          `mapstructure:"host"`
+	HostID       string          `mapstructure:"host_id"`
+	Port         string          `mapstructure:"port"`
+	Metrics      Metrics         `mapstructure:"metrics"`
+	System       SystemPoints    `mapstructure:"system"`
+	Services     []Service       `mapstructure:"services"`
+	DiskUsage    DiskUsageFilter `mapstructure:"disk_usage"`
+	Forecast     Forecast        `mapstructure:"forecast"`
+	Interrupts   Interrupts      `mapstructure:"interrupts"`
+	Conntrack    Conntrack       `mapstructure:"conntrack"`
+	Plugins      []Plugin        `mapstructure:"plugins"`
+	Aggregations []string        `mapstructure:"aggregations"`
+	Intervals    Intervals       `mapstructure:"intervals"`
+	Alerts       Alerts          `mapstructure:"alerts"`
+	Anomaly      Anomaly         `mapstructure:"anomaly"`
+	LogLevel     string          `mapstructure:"log_level"`
 }
 
 func (d *DaemonConfig) Validate() error {
@@ -51,9 +183,110 @@ func (d *DaemonConfig) Validate() error {
 		errors.As(err, &fe)
 		return fmt.Errorf("invalid log level value: %s", fe[0].Value())
 	}
+	for _, a := range d.Aggregations {
+		if err = validate.Var(a, "oneof=mean min max last p50 p95 p99 stddev"); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidAggregation, a)
+		}
+	}
+	for name, v := range d.Intervals {
+		if !slices.Contains(Collectors, name) {
+			return fmt.Errorf("%w: unknown collector %q", ErrInvalidInterval, name)
+		}
+		if v < MinInterval {
+			return fmt.Errorf("%w: %s: %s is less than %s", ErrInvalidInterval, name, v, MinInterval)
+		}
+	}
+	for _, p := range d.Plugins {
+		if p.Name == "" || len(p.Command) == 0 {
+			return fmt.Errorf("%w: name and command are required", ErrInvalidPlugin)
+		}
+		if err = validate.Var(p.Format, "oneof=json prometheus influx"); err != nil {
+			return fmt.Errorf("%w: %s: unknown format %q", ErrInvalidPlugin, p.Name, p.Format)
+		}
+	}
+	if err = d.validateAlerts(validate); err != nil {
+		return err
+	}
+	return d.validateAnomaly(validate)
+}
+
+// validateAlerts проверяет имена правил, период проверки и адреса webhook. Условия правил
+// разбираются при запуске проверки.
+func (d *DaemonConfig) validateAlerts(validate *validator.Validate) error {
+	a := d.Alerts
+	if a.Interval%time.Second != 0 || a.Interval < time.Second || a.Interval > MaxAlertInterval {
+		return fmt.Errorf(
+			"%w: interval must be a whole number of seconds from 1s to %s", ErrInvalidAlerts, MaxAlertInterval,
+		)
+	}
+	names := make(map[string]bool, len(a.Rules))
+	for _, r := range a.Rules {
+		if r.Name == "" || r.Expr == "" {
+			return fmt.Errorf("%w: name and expr are required", ErrInvalidAlerts)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("%w: duplicate rule %s", ErrInvalidAlerts, r.Name)
+		}
+		names[r.Name] = true
+	}
+	for _, u := range a.Webhooks {
+		if err := validate.Var(u, "http_url"); err != nil {
+			return fmt.Errorf("%w: invalid webhook url %q", ErrInvalidAlerts, u)
+		}
+	}
+	if a.Retries < 0 {
+		return fmt.Errorf("%w: retries must not be negative", ErrInvalidAlerts)
+	}
 	return nil
 }
 
+// validateAnomaly проверяет период обучения и параметры детекторов. Имена метрик проверяются
+// при запуске обнаружения аномалий.
+func (d *DaemonConfig) validateAnomaly(validate *validator.Validate) error {
+	a := d.Anomaly
+	if a.Interval%time.Second != 0 || a.Interval < time.Second || a.Interval > MaxAlertInterval {
+		return fmt.Errorf(
+			"%w: interval must be a whole number of seconds from 1s to %s", ErrInvalidAnomaly, MaxAlertInterval,
+		)
+	}
+	for name, m := range a.Metrics {
+		if err := validate.Var(m.Method, "omitempty,oneof=ewma seasonal"); err != nil {
+			return fmt.Errorf("%w: %s: unknown method %q", ErrInvalidAnomaly, name, m.Method)
+		}
+		if m.Alpha < 0 || m.Alpha > 1 {
+			return fmt.Errorf("%w: %s: alpha must be from 0 to 1", ErrInvalidAnomaly, name)
+		}
+		if m.Season < 0 || m.Buckets < 0 || m.Threshold < 0 || m.Warmup < 0 || m.MinSpread < 0 {
+			return fmt.Errorf("%w: %s: parameters must not be negative", ErrInvalidAnomaly, name)
+		}
+	}
+	return nil
+}
+
+// intervalsMu защищает Intervals: настройки перечитываются при изменении файла, пока потоки
+// клиентов запускают сборщики.
+var intervalsMu sync.RWMutex
+
+// Interval возвращает период измерений сборщика name.
+func (d *DaemonConfig) Interval(name string) time.Duration {
+	intervalsMu.RLock()
+	defer intervalsMu.RUnlock()
+	if v, ok := d.Intervals[name]; ok && v > 0 {
+		return v
+	}
+	return DefaultInterval
+}
+
+// HostName возвращает идентификатор узла, который передается в снимках. Если он не задан
+// в настройках, используется имя узла.
+func (d *DaemonConfig) HostName() string {
+	if d.HostID != "" {
+		return d.HostID
+	}
+	name, _ := os.Hostname()
+	return name
+}
+
 func Load(path string, cfg interface{}) error {
 	setDefaults()
 	viper.SetConfigType("yaml")
@@ -72,6 +305,12 @@ func Load(path string, cfg interface{}) error {
 			viper.Set(k, os.Getenv(key))
 		}
 	}
+	intervalsMu.Lock()
+	defer intervalsMu.Unlock()
+	if d, ok := cfg.(*DaemonConfig); ok {
+		// Карта заполняется заново, иначе ключи, удаленные из файла, сохраняются до перезапуска
+		d.Intervals = nil
+	}
 	if err = viper.Unmarshal(cfg); err != nil {
 		return fmt.Errorf("%w: %s", ErrConfigBindingFail, err.Error())
 	}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalsReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("intervals:\n    cpu_avg: 500ms\n    disk_usage: 2s\n")
+
+	var cfg DaemonConfig
+	require.NoError(t, Load(path, &cfg))
+	require.Equal(t, 500*time.Millisecond, cfg.Interval(CollectorCPUAvg))
+	require.Equal(t, 2*time.Second, cfg.Interval(CollectorDiskUsage))
+
+	// Потоки клиентов читают интервалы во время перечитывания настроек
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					_ = cfg.Interval(CollectorCPUAvg)
+				}
+			}
+		}()
+	}
+	write("intervals:\n    cpu_avg: 300ms\n")
+	for i := 0; i < 20; i++ {
+		require.NoError(t, Load(path, &cfg))
+	}
+	close(stop)
+	wg.Wait()
+
+	require.Equal(t, 300*time.Millisecond, cfg.Interval(CollectorCPUAvg))
+	// Ключ удален из файла, используется период по умолчанию
+	require.Equal(t, DefaultInterval, cfg.Interval(CollectorDiskUsage))
+}
@@ -13,6 +13,32 @@ func setDefaults() {
 	viper.SetDefault("metrics.net_connections_states", true)
 	viper.SetDefault("metrics.net_top_by_protocol", true)
 	viper.SetDefault("metrics.net_top_by_connection", true)
+	viper.SetDefault("metrics.net_top_by_process", true)
+	viper.SetDefault("metrics.dns", true)
+	viper.SetDefault("metrics.sensors", true)
+	viper.SetDefault("metrics.net_stack", true)
+	viper.SetDefault("metrics.interrupts", true)
+	viper.SetDefault("metrics.plugins", true)
+	viper.SetDefault("metrics.users", true)
+	viper.SetDefault("metrics.numa", true)
+	viper.SetDefault("metrics.raid", true)
+	viper.SetDefault("metrics.conntrack", true)
+	viper.SetDefault("metrics.kernel_events", true)
+
+	viper.SetDefault("forecast.windows", []string{"1h", "24h"})
+	viper.SetDefault("forecast.threshold", "24h")
+
+	viper.SetDefault("interrupts.imbalance_percent", 80)
+	viper.SetDefault("interrupts.min_rate", 100)
+
+	viper.SetDefault("conntrack.entries", false)
+
+	viper.SetDefault("alerts.interval", "10s")
+	viper.SetDefault("alerts.timeout", "5s")
+	viper.SetDefault("alerts.retries", 3)
+	viper.SetDefault("alerts.retry_delay", "1s")
+
+	viper.SetDefault("anomaly.interval", "10s")
 
 	viper.SetDefault("host", "0.0.0.0")
 	viper.SetDefault("port", "50051")
@@ -27,4 +53,5 @@ func setDefaults() {
 	viper.SetDefault("system.udp6", "/proc/net/udp6")
 	viper.SetDefault("system.procMountInfo", "")
 	viper.SetDefault("system.interface", "any")
+	viper.SetDefault("system.kmsg", "/dev/kmsg")
 }
@@ -3,6 +3,11 @@ package config
 import "errors"
 
 var (
-	ErrConfigLoadFail    = errors.New("failed to load configuration file")
-	ErrConfigBindingFail = errors.New("failed to binding configuration")
+	ErrConfigLoadFail     = errors.New("failed to load configuration file")
+	ErrConfigBindingFail  = errors.New("failed to binding configuration")
+	ErrInvalidPlugin      = errors.New("invalid plugin configuration")
+	ErrInvalidAggregation = errors.New("unknown aggregation function")
+	ErrInvalidInterval    = errors.New("invalid sampling interval")
+	ErrInvalidAlerts      = errors.New("invalid alerts configuration")
+	ErrInvalidAnomaly     = errors.New("invalid anomaly detection configuration")
 )
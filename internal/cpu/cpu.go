@@ -4,10 +4,25 @@ import (
 	"encoding/json"
 )
 
+// SoftirqStat - скорость обработки отложенных прерываний одного типа, суммарно по всем ядрам.
+type SoftirqStat struct {
+	Name string
+	Rate float64
+}
+
+// Data - загрузка процессора и активность ядра. Скорости считаются в секунду
+// с момента предыдущего измерения, ProcsRunning и ProcsBlocked - текущие значения.
 type Data struct {
 	User   float64
 	Idle   float64
 	System float64
+
+	ContextSwitches float64
+	Interrupts      float64
+	Forks           float64
+	ProcsRunning    uint64
+	ProcsBlocked    uint64
+	Softirqs        []SoftirqStat
 }
 
 func (c Data) String() string {
@@ -18,6 +18,20 @@ import (
 
 var ClocksPerSec = float64(100)
 
+// kernelCounters - накопительные счетчики активности ядра из /proc/stat и /proc/softirqs.
+type kernelCounters struct {
+	time     time.Time
+	ctxt     uint64
+	intr     uint64
+	forks    uint64
+	softirqs []softirqCounter
+}
+
+type softirqCounter struct {
+	name  string
+	value uint64
+}
+
 type TimesStat struct {
 	CPU             string
 	User            float64
@@ -55,6 +69,8 @@ type LinuxCPUCollector struct {
 	Steal           float64
 	Guest           float64
 	GuestNice       float64
+
+	prev *kernelCounters
 }
 
 func NewLinuxCPUCollector(
@@ -71,7 +87,7 @@ func NewLinuxCPUCollector(
 func (l *LinuxCPUCollector) Get() (*cpu.Data, error) {
 	filename := filepath.Join(l.cfg.System.Proc, "stat")
 	var lines []string
-	lines, err := utils.ReadLinesOffsetN(filename, 0, 1)
+	lines, err := utils.ReadLines(filename)
 	if len(lines) == 0 || err != nil {
 		return nil, errors.New("no cpu stat")
 	}
@@ -79,11 +95,105 @@ func (l *LinuxCPUCollector) Get() (*cpu.Data, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &cpu.Data{
+	data := &cpu.Data{
 		User:   l.UserInPercent,
 		Idle:   l.IdleInPercent,
 		System: l.SystemInPercent,
-	}, nil
+	}
+
+	cur := &kernelCounters{time: time.Now()}
+	parseKernelActivity(lines, cur, data)
+	// /proc/softirqs может отсутствовать, например, в контейнере
+	if lines, err := utils.ReadLines(filepath.Join(l.cfg.System.Proc, "softirqs")); err == nil {
+		cur.softirqs = parseSoftirqs(lines)
+	}
+	calcKernelRates(l.prev, cur, data)
+	l.prev = cur
+	return data, nil
+}
+
+// parseKernelActivity разбирает строки ctxt, intr, processes, procs_running и procs_blocked из /proc/stat.
+func parseKernelActivity(lines []string, counters *kernelCounters, data *cpu.Data) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		// Для intr первое значение - общее количество прерываний, далее идет разбивка по номерам
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "ctxt":
+			counters.ctxt = v
+		case "intr":
+			counters.intr = v
+		case "processes":
+			counters.forks = v
+		case "procs_running":
+			data.ProcsRunning = v
+		case "procs_blocked":
+			data.ProcsBlocked = v
+		}
+	}
+}
+
+// parseSoftirqs суммирует счетчики /proc/softirqs по всем ядрам.
+func parseSoftirqs(lines []string) []softirqCounter {
+	result := make([]softirqCounter, 0, len(lines))
+	// Первая строка - заголовок с именами процессоров
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		c := softirqCounter{name: strings.TrimSuffix(fields[0], ":")}
+		for _, f := range fields[1:] {
+			if v, err := strconv.ParseUint(f, 10, 64); err == nil {
+				c.value += v
+			}
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func counterRate(cur, prev uint64, seconds float64) float64 {
+	// Уменьшение счетчика означает его сброс, такой интервал пропускаем
+	if cur < prev {
+		return 0
+	}
+	return float64(cur-prev) / seconds
+}
+
+// calcKernelRates считает скорости счетчиков ядра между двумя измерениями.
+// Без предыдущего измерения скорости нулевые.
+func calcKernelRates(prev, cur *kernelCounters, data *cpu.Data) {
+	data.Softirqs = make([]cpu.SoftirqStat, 0, len(cur.softirqs))
+	for _, c := range cur.softirqs {
+		data.Softirqs = append(data.Softirqs, cpu.SoftirqStat{Name: c.name})
+	}
+	if prev == nil {
+		return
+	}
+	seconds := cur.time.Sub(prev.time).Seconds()
+	if seconds <= 0 {
+		return
+	}
+	data.ContextSwitches = counterRate(cur.ctxt, prev.ctxt, seconds)
+	data.Interrupts = counterRate(cur.intr, prev.intr, seconds)
+	data.Forks = counterRate(cur.forks, prev.forks, seconds)
+
+	prevSoftirqs := make(map[string]uint64, len(prev.softirqs))
+	for _, c := range prev.softirqs {
+		prevSoftirqs[c.name] = c.value
+	}
+	for i, c := range cur.softirqs {
+		if p, ok := prevSoftirqs[c.name]; ok {
+			data.Softirqs[i].Rate = counterRate(c.value, p, seconds)
+		}
+	}
 }
 
 func (l *LinuxCPUCollector) parseStatLine(line string) error {
@@ -168,7 +278,7 @@ func (l *LinuxCPUCollector) parseStatLine(line string) error {
 
 func (l *LinuxCPUCollector) Run() (<-chan *cpu.Data, error) {
 	ch := make(chan *cpu.Data)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorCPUAvg))
 	if _, err := l.Get(); err != nil {
 		l.cfg.Metrics.CPUAvg = false
 		return nil, err
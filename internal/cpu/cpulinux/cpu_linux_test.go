@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -184,3 +185,54 @@ func TestCPUWithMocks(t *testing.T) {
 		require.False(t, cfg.Metrics.CPUAvg)
 	})
 }
+
+func TestKernelActivity(t *testing.T) {
+	log.Disable()
+
+	t.Run("cpu: kernel activity from fake proc", func(t *testing.T) {
+		root := t.TempDir()
+		stat := "cpu  1826207 68727 673820 42671281 86015 158628 47813 0 0 0\n" +
+			"cpu0 913103 34363 336910 21335640 43007 79314 23906 0 0 0\n" +
+			"intr 5000 10 20 30\nctxt 123456\nbtime 1700000000\nprocesses 4321\n" +
+			"procs_running 3\nprocs_blocked 1\nsoftirq 900 1 2 3\n"
+		softirqs := "                    CPU0       CPU1\n" +
+			"          HI:          1          2\n" +
+			"       TIMER:        100        200\n" +
+			"      NET_RX:         10          5\n"
+		require.NoError(t, os.WriteFile(filepath.Join(root, "stat"), []byte(stat), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "softirqs"), []byte(softirqs), 0o600))
+
+		cfg := createConfig()
+		cfg.System.Proc = root
+		v := NewLinuxCPUCollector(context.TODO(), context.TODO(), cfg, log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), val.ProcsRunning)
+		require.Equal(t, uint64(1), val.ProcsBlocked)
+		require.Zero(t, val.ContextSwitches)
+		require.Equal(t, []cpu.SoftirqStat{{Name: "HI"}, {Name: "TIMER"}, {Name: "NET_RX"}}, val.Softirqs)
+		require.Equal(t, uint64(123456), v.prev.ctxt)
+		require.Equal(t, uint64(5000), v.prev.intr)
+		require.Equal(t, uint64(4321), v.prev.forks)
+		require.Equal(t, uint64(300), v.prev.softirqs[1].value)
+	})
+
+	t.Run("cpu: kernel activity rates", func(t *testing.T) {
+		now := time.Now()
+		prev := &kernelCounters{
+			time: now, ctxt: 1000, intr: 500, forks: 10,
+			softirqs: []softirqCounter{{"TIMER", 100}, {"NET_RX", 50}},
+		}
+		cur := &kernelCounters{
+			time: now.Add(2 * time.Second), ctxt: 3000, intr: 400, forks: 14,
+			softirqs: []softirqCounter{{"TIMER", 300}, {"NET_RX", 60}, {"BLOCK", 7}},
+		}
+		data := &cpu.Data{}
+		calcKernelRates(prev, cur, data)
+		require.InDelta(t, 1000.0, data.ContextSwitches, 1e-9)
+		// Счетчик сброшен, скорость за интервал не считается
+		require.Zero(t, data.Interrupts)
+		require.InDelta(t, 2.0, data.Forks, 1e-9)
+		require.Equal(t, []cpu.SoftirqStat{{Name: "TIMER", Rate: 100}, {Name: "NET_RX", Rate: 5}, {Name: "BLOCK"}}, data.Softirqs)
+	})
+}
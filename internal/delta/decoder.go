@@ -0,0 +1,54 @@
+package delta
+
+import (
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Decoder восстанавливает полные снимки по сообщениям потока, созданным Encoder.
+type Decoder struct {
+	ready       bool
+	connections *list[*pb.NetConnection]
+	diskUsage   *list[*pb.DiskUsage]
+	diskIO      *list[*pb.DiskIO]
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{
+		connections: newList(connectionKey),
+		diskUsage:   newList(diskUsageKey),
+		diskIO:      newList(diskIOKey),
+	}
+}
+
+// Decode заполняет списки снимка по сохраненному состоянию и изменениям из сообщения.
+// Полный снимок заменяет сохраненное состояние.
+func (d *Decoder) Decode(snapshot *pb.Snapshot) error {
+	if snapshot.Delta == nil {
+		d.ready = true
+		d.connections.reset(snapshot.NetConnections)
+		d.diskUsage.reset(snapshot.DiskUsage)
+		d.diskIO.reset(snapshot.DiskIO)
+		return nil
+	}
+	if !d.ready {
+		return ErrNoBase
+	}
+
+	if v := snapshot.Delta.NetConnections; v != nil {
+		snapshot.NetConnections = d.connections.apply(v.Added, v.Changed, v.Removed, v.Order)
+	} else {
+		d.connections.reset(nil)
+	}
+	if v := snapshot.Delta.DiskUsage; v != nil {
+		snapshot.DiskUsage = d.diskUsage.apply(v.Added, v.Changed, v.Removed, v.Order)
+	} else {
+		d.diskUsage.reset(nil)
+	}
+	if v := snapshot.Delta.DiskIO; v != nil {
+		snapshot.DiskIO = d.diskIO.apply(v.Added, v.Changed, v.Removed, v.Order)
+	} else {
+		d.diskIO.reset(nil)
+	}
+	snapshot.Delta = nil
+	return nil
+}
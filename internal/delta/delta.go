@@ -0,0 +1,130 @@
+package delta
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultResync - количество сообщений между полными снимками, если клиент не задал период.
+const DefaultResync = 60
+
+// ErrNoBase возвращается, если изменения получены раньше полного снимка.
+var ErrNoBase = errors.New("delta received before full snapshot")
+
+func connectionKey(v *pb.NetConnection) string { return v.SocketId }
+
+func diskUsageKey(v *pb.DiskUsage) string { return v.MountPoint }
+
+func diskIOKey(v *pb.DiskIO) string { return v.Name }
+
+// list - состояние списка снимка. Порядок элементов сохраняется, новые элементы добавляются в конец,
+// если порядок не передан явно. Порядок передается только для списков, отсортированных запросом клиента
+// (ordered), остальные списки сортируются по ключу перед сравнением.
+type list[T proto.Message] struct {
+	key     func(T) string
+	items   map[string]T
+	order   []string
+	ordered bool
+}
+
+func newList[T proto.Message](key func(T) string) *list[T] {
+	return &list[T]{key: key, items: make(map[string]T)}
+}
+
+// sort упорядочивает элементы списка без заданного запросом порядка по ключу. Сборщики формируют
+// списки из map, без сортировки порядок меняется в каждом снимке.
+func (l *list[T]) sort(current []T) {
+	if !l.ordered {
+		sort.SliceStable(current, func(i, j int) bool { return l.key(current[i]) < l.key(current[j]) })
+	}
+}
+
+func (l *list[T]) reset(current []T) {
+	l.items = make(map[string]T, len(current))
+	l.order = l.order[:0]
+	for _, v := range current {
+		k := l.key(v)
+		if _, ok := l.items[k]; !ok {
+			l.order = append(l.order, k)
+		}
+		l.items[k] = v
+	}
+}
+
+// diff возвращает изменения current относительно сохраненного состояния и сохраняет current.
+// Порядок ключей order возвращается только для списков с заданным порядком и только если
+// apply не восстановит его по изменениям.
+func (l *list[T]) diff(current []T) (added, changed []T, removed, order []string) {
+	prev, prevOrder := l.items, slices.Clone(l.order)
+	l.reset(current)
+	for _, k := range l.order {
+		v := l.items[k]
+		old, ok := prev[k]
+		switch {
+		case !ok:
+			added = append(added, v)
+		case !proto.Equal(old, v):
+			changed = append(changed, v)
+		}
+	}
+	for k := range prev {
+		if _, ok := l.items[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	if !l.ordered {
+		return added, changed, removed, nil
+	}
+
+	expected := make([]string, 0, len(l.order))
+	for _, k := range prevOrder {
+		if _, ok := l.items[k]; ok {
+			expected = append(expected, k)
+		}
+	}
+	for _, v := range added {
+		expected = append(expected, l.key(v))
+	}
+	if !slices.Equal(expected, l.order) {
+		order = slices.Clone(l.order)
+	}
+	return added, changed, removed, order
+}
+
+// apply применяет изменения к сохраненному состоянию и возвращает полный список.
+// Если order задан, элементы возвращаются в этом порядке.
+func (l *list[T]) apply(added, changed []T, removed, order []string) []T {
+	for _, k := range removed {
+		delete(l.items, k)
+	}
+	kept := l.order[:0]
+	for _, k := range l.order {
+		if _, ok := l.items[k]; ok {
+			kept = append(kept, k)
+		}
+	}
+	l.order = kept
+	for _, v := range changed {
+		l.items[l.key(v)] = v
+	}
+	for _, v := range added {
+		k := l.key(v)
+		if _, ok := l.items[k]; !ok {
+			l.order = append(l.order, k)
+		}
+		l.items[k] = v
+	}
+	if len(order) > 0 {
+		l.order = append(l.order[:0], order...)
+	}
+	result := make([]T, 0, len(l.order))
+	for _, k := range l.order {
+		result = append(result, l.items[k])
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+package delta
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/skushnerchuk/simda/internal/query"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func conn(id, state string) *pb.NetConnection {
+	return &pb.NetConnection{SocketId: id, Protocol: "tcp", State: state}
+}
+
+func snapshots() []*pb.Snapshot {
+	return []*pb.Snapshot{
+		{
+			Seq:            1,
+			NetConnections: []*pb.NetConnection{conn("1", "LISTEN"), conn("2", "ESTABLISHED")},
+			DiskUsage:      []*pb.DiskUsage{{MountPoint: "/", UsagePercent: 10}},
+			DiskIO:         []*pb.DiskIO{{Name: "sda", Tps: 1}},
+		},
+		{
+			Seq:            2,
+			NetConnections: []*pb.NetConnection{conn("1", "LISTEN"), conn("2", "CLOSE_WAIT"), conn("3", "SYN_SENT")},
+			DiskUsage:      []*pb.DiskUsage{{MountPoint: "/", UsagePercent: 10}},
+			DiskIO:         []*pb.DiskIO{{Name: "sda", Tps: 2}},
+		},
+		{
+			Seq:            3,
+			NetConnections: []*pb.NetConnection{conn("1", "LISTEN"), conn("3", "ESTABLISHED")},
+			DiskUsage:      []*pb.DiskUsage{{MountPoint: "/", UsagePercent: 11}, {MountPoint: "/home"}},
+		},
+		{
+			Seq:            4,
+			NetConnections: []*pb.NetConnection{},
+			DiskUsage:      []*pb.DiskUsage{{MountPoint: "/home"}},
+			DiskIO:         []*pb.DiskIO{{Name: "sda", Tps: 2}},
+		},
+	}
+}
+
+func TestDelta(t *testing.T) {
+	t.Run("delta: changes", func(t *testing.T) {
+		items := snapshots()
+		e := NewEncoder(0, nil)
+		for _, s := range items[:2] {
+			e.Encode(s)
+		}
+		require.Nil(t, items[0].Delta)
+		require.Len(t, items[0].NetConnections, 2)
+
+		d := items[1].Delta
+		require.NotNil(t, d)
+		require.Nil(t, items[1].NetConnections)
+		require.Nil(t, items[1].DiskUsage)
+		require.Nil(t, items[1].DiskIO)
+		require.Equal(t, []string{"3"}, connectionKeys(d.NetConnections.Added))
+		require.Equal(t, []string{"2"}, connectionKeys(d.NetConnections.Changed))
+		require.Empty(t, d.NetConnections.Removed)
+		require.Empty(t, d.DiskUsage.Added)
+		require.Empty(t, d.DiskUsage.Changed)
+		require.Len(t, d.DiskIO.Changed, 1)
+	})
+
+	t.Run("delta: round trip", func(t *testing.T) {
+		e := NewEncoder(0, nil)
+		dec := NewDecoder()
+		for _, s := range snapshots() {
+			expected := proto.Clone(s).(*pb.Snapshot)
+			e.Encode(s)
+			require.NoError(t, dec.Decode(s))
+			require.True(t, proto.Equal(expected, s), "seq %d: %v != %v", s.Seq, expected, s)
+		}
+	})
+
+	t.Run("delta: disabled metric", func(t *testing.T) {
+		items := snapshots()
+		e := NewEncoder(0, nil)
+		dec := NewDecoder()
+		e.Encode(items[0])
+		require.NoError(t, dec.Decode(items[0]))
+		// Метрика отключена, список не передается
+		e.Encode(items[2])
+		require.Nil(t, items[2].Delta.DiskIO)
+		require.NoError(t, dec.Decode(items[2]))
+		require.Nil(t, items[2].DiskIO)
+		// После включения все элементы передаются как новые
+		e.Encode(items[3])
+		require.Len(t, items[3].Delta.DiskIO.Added, 1)
+		require.NoError(t, dec.Decode(items[3]))
+		require.Len(t, items[3].DiskIO, 1)
+	})
+
+	t.Run("delta: resync", func(t *testing.T) {
+		e := NewEncoder(2, nil)
+		items := snapshots()
+		for _, s := range items {
+			e.Encode(s)
+		}
+		require.Nil(t, items[0].Delta)
+		require.NotNil(t, items[1].Delta)
+		require.Nil(t, items[2].Delta)
+		require.NotNil(t, items[3].Delta)
+	})
+
+	t.Run("delta: order by query", func(t *testing.T) {
+		queries, err := query.New([]*pb.Query{{Target: query.TargetDiskIO, OrderBy: "tps", Desc: true}})
+		require.NoError(t, err)
+		disks := func(seq uint64, tps ...float64) *pb.Snapshot {
+			s := &pb.Snapshot{Seq: seq}
+			for i, v := range tps {
+				s.DiskIO = append(s.DiskIO, &pb.DiskIO{Name: fmt.Sprintf("sd%c", 'a'+i), Tps: v})
+			}
+			queries.Apply(s)
+			return s
+		}
+		e := NewEncoder(0, queries)
+		dec := NewDecoder()
+		for _, s := range []*pb.Snapshot{
+			disks(1, 3, 2, 1),
+			disks(2, 3, 2, 1, 4),
+			disks(3, 1, 2, 3),
+			disks(4, 5, 2),
+		} {
+			expected := proto.Clone(s).(*pb.Snapshot)
+			e.Encode(s)
+			if s.Seq == 2 {
+				require.Equal(t, []string{"sdd", "sda", "sdb", "sdc"}, s.Delta.DiskIO.Order)
+			}
+			require.NoError(t, dec.Decode(s))
+			require.True(t, proto.Equal(expected, s), "seq %d: %v != %v", s.Seq, expected, s)
+		}
+
+		// Порядок не изменился, ключи не передаются
+		unchanged := disks(5, 5, 2)
+		e.Encode(unchanged)
+		require.Empty(t, unchanged.Delta.DiskIO.Order)
+	})
+
+	t.Run("delta: unordered list", func(t *testing.T) {
+		connections := func(seq uint64) *pb.Snapshot {
+			s := &pb.Snapshot{Seq: seq}
+			for i := 0; i < 100; i++ {
+				s.NetConnections = append(s.NetConnections, conn(fmt.Sprintf("%03d", i), "ESTABLISHED"))
+			}
+			rand.Shuffle(len(s.NetConnections), func(i, j int) {
+				s.NetConnections[i], s.NetConnections[j] = s.NetConnections[j], s.NetConnections[i]
+			})
+			return s
+		}
+		e := NewEncoder(0, nil)
+		dec := NewDecoder()
+		first := connections(1)
+		e.Encode(first)
+		require.NoError(t, dec.Decode(first))
+		for seq := uint64(2); seq <= 10; seq++ {
+			s := connections(seq)
+			e.Encode(s)
+			// Порядок входного списка меняется, элементы - нет
+			require.Empty(t, s.Delta.NetConnections.Added)
+			require.Empty(t, s.Delta.NetConnections.Changed)
+			require.Empty(t, s.Delta.NetConnections.Removed)
+			require.Empty(t, s.Delta.NetConnections.Order)
+			require.NoError(t, dec.Decode(s))
+			require.Equal(t, connectionKeys(first.NetConnections), connectionKeys(s.NetConnections))
+		}
+	})
+
+	t.Run("delta: no base", func(t *testing.T) {
+		e := NewEncoder(0, nil)
+		items := snapshots()
+		e.Encode(items[0])
+		e.Encode(items[1])
+		require.ErrorIs(t, NewDecoder().Decode(items[1]), ErrNoBase)
+	})
+}
+
+func connectionKeys(items []*pb.NetConnection) []string {
+	result := make([]string, 0, len(items))
+	for _, v := range items {
+		result = append(result, v.SocketId)
+	}
+	return result
+}
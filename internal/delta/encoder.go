@@ -0,0 +1,79 @@
+package delta
+
+import (
+	"github.com/skushnerchuk/simda/internal/query"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Encoder заменяет списки соединений, файловых систем и дисков в снимках потока их изменениями
+// относительно предыдущего сообщения. Первое и каждое resync-е сообщение передаются полностью.
+type Encoder struct {
+	resync      uint32
+	count       uint32
+	connections *list[*pb.NetConnection]
+	diskUsage   *list[*pb.DiskUsage]
+	diskIO      *list[*pb.DiskIO]
+}
+
+// NewEncoder создает кодировщик. Порядок элементов передается клиенту только для списков,
+// отсортированных запросами queries.
+func NewEncoder(resync uint32, queries *query.Set) *Encoder {
+	if resync == 0 {
+		resync = DefaultResync
+	}
+	e := &Encoder{
+		resync:      resync,
+		connections: newList(connectionKey),
+		diskUsage:   newList(diskUsageKey),
+		diskIO:      newList(diskIOKey),
+	}
+	e.connections.ordered = queries.Ordered(query.TargetNetConnections)
+	e.diskUsage.ordered = queries.Ordered(query.TargetDiskUsage)
+	e.diskIO.ordered = queries.Ordered(query.TargetDiskIO)
+	return e
+}
+
+// Encode изменяет снимок перед отправкой клиенту.
+func (e *Encoder) Encode(snapshot *pb.Snapshot) {
+	full := e.count%e.resync == 0
+	e.count++
+	e.connections.sort(snapshot.NetConnections)
+	e.diskUsage.sort(snapshot.DiskUsage)
+	e.diskIO.sort(snapshot.DiskIO)
+	if full {
+		e.connections.reset(snapshot.NetConnections)
+		e.diskUsage.reset(snapshot.DiskUsage)
+		e.diskIO.reset(snapshot.DiskIO)
+		return
+	}
+
+	result := &pb.SnapshotDelta{}
+	if snapshot.NetConnections != nil {
+		added, changed, removed, order := e.connections.diff(snapshot.NetConnections)
+		result.NetConnections = &pb.NetConnectionsDelta{
+			Added: added, Changed: changed, Removed: removed, Order: order,
+		}
+	} else {
+		e.connections.reset(nil)
+	}
+	if snapshot.DiskUsage != nil {
+		added, changed, removed, order := e.diskUsage.diff(snapshot.DiskUsage)
+		result.DiskUsage = &pb.DiskUsageDelta{
+			Added: added, Changed: changed, Removed: removed, Order: order,
+		}
+	} else {
+		e.diskUsage.reset(nil)
+	}
+	if snapshot.DiskIO != nil {
+		added, changed, removed, order := e.diskIO.diff(snapshot.DiskIO)
+		result.DiskIO = &pb.DiskIODelta{
+			Added: added, Changed: changed, Removed: removed, Order: order,
+		}
+	} else {
+		e.diskIO.reset(nil)
+	}
+	snapshot.NetConnections = nil
+	snapshot.DiskUsage = nil
+	snapshot.DiskIO = nil
+	snapshot.Delta = result
+}
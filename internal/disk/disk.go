@@ -2,10 +2,65 @@ package disk
 
 import (
 	"encoding/json"
+	"sort"
 )
 
+const (
+	KindDisk      = "disk"
+	KindPartition = "part"
+	KindDM        = "dm"
+	KindMD        = "md"
+	KindLoop      = "loop"
+)
+
+// Device - блочное устройство. Label - имя device-mapper (например, vg0-root), Parent - диск,
+// которому принадлежит раздел, Slaves - устройства, на которых построены dm и md.
+type Device struct {
+	Name   string
+	Label  string
+	Kind   string
+	Parent string
+	Slaves []string
+}
+
+// Topology - блочные устройства по именам ядра.
+type Topology map[string]Device
+
+// Disks возвращает физические диски, на которых расположено устройство: для раздела - его диск,
+// для dm и md - диски их составляющих.
+func (t Topology) Disks(name string) []string {
+	result := make([]string, 0)
+	seen := make(map[string]bool)
+	var walk func(name string)
+	walk = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		d, ok := t[name]
+		switch {
+		case !ok:
+			result = append(result, name)
+		case d.Parent != "":
+			walk(d.Parent)
+		case len(d.Slaves) > 0:
+			for _, s := range d.Slaves {
+				walk(s)
+			}
+		default:
+			result = append(result, name)
+		}
+	}
+	walk(name)
+	sort.Strings(result)
+	return result
+}
+
 type IOStat struct {
 	Name    string
+	Label   string
+	Kind    string
+	Disks   []string
 	Tps     float64
 	RdSpeed float64
 	WrSpeed float64
@@ -27,10 +82,17 @@ type UsageStat struct {
 	Device                string
 	Mountpoint            string
 	Type                  string
+	ReadOnly              bool
+	Stale                 bool
 	UsagePercent          float64
 	Usage                 float64
 	INodeCount            float64
 	INodeAvailablePercent float64
+	Total                 uint64
+	Used                  uint64
+	Free                  uint64
+	INodeUsed             uint64
+	INodeFree             uint64
 }
 
 type UsageStatMap map[string]*UsageStat
@@ -188,8 +188,13 @@ func (l *LinuxDiskIOCollector) Run() (<-chan disk.IOStatMap, error) {
 		l.cfg.Metrics.DiskIO = false
 		return nil, err
 	}
+	// Без топологии устройства выводятся под именами ядра
+	topology, err := disk.GetTopology(l.cfg.System.Sys)
+	if err != nil {
+		l.l.Warn("failed to get block devices topology", "error", err.Error())
+	}
 	ch := make(chan disk.IOStatMap)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorDiskIO))
 
 	go func() {
 		defer close(ch)
@@ -210,6 +215,7 @@ func (l *LinuxDiskIOCollector) Run() (<-chan disk.IOStatMap, error) {
 						l.l.Error("failed to get disk i/o stat", "error", err.Error(), "device", device)
 						continue
 					}
+					setTopology(stat, topology)
 					message[device] = stat
 				}
 				ch <- message
@@ -218,3 +224,16 @@ func (l *LinuxDiskIOCollector) Run() (<-chan disk.IOStatMap, error) {
 	}()
 	return ch, nil
 }
+
+// setTopology дополняет статистику именем device-mapper и дисками, на которых расположено устройство.
+func setTopology(stat *disk.IOStat, topology disk.Topology) {
+	d, ok := topology[stat.Name]
+	if !ok {
+		return
+	}
+	stat.Label = d.Label
+	stat.Kind = d.Kind
+	if d.Kind == disk.KindPartition || d.Kind == disk.KindDM || d.Kind == disk.KindMD {
+		stat.Disks = topology.Disks(stat.Name)
+	}
+}
@@ -136,6 +136,21 @@ func TestDiskIOStat(t *testing.T) { //nolint:funlen
 		require.Nil(t, val)
 	})
 
+	t.Run("disk i/o: topology", func(t *testing.T) {
+		topology := disk.Topology{
+			"sda":  {Name: "sda", Kind: disk.KindDisk},
+			"sda1": {Name: "sda1", Kind: disk.KindPartition, Parent: "sda"},
+			"dm-0": {Name: "dm-0", Label: "vg0-root", Kind: disk.KindDM, Slaves: []string{"sda1"}},
+		}
+		stat := &disk.IOStat{Name: "dm-0"}
+		setTopology(stat, topology)
+		require.Equal(t, &disk.IOStat{Name: "dm-0", Label: "vg0-root", Kind: disk.KindDM, Disks: []string{"sda"}}, stat)
+
+		stat = &disk.IOStat{Name: "sda"}
+		setTopology(stat, topology)
+		require.Equal(t, &disk.IOStat{Name: "sda", Kind: disk.KindDisk}, stat)
+	})
+
 	t.Run("disk i/o: Run() error", func(t *testing.T) {
 		cfg.Metrics.DiskIO = true
 		v := NewLinuxDiskIOCollector(context.TODO(), context.TODO(), &cfg, log)
@@ -0,0 +1,180 @@
+//go:build linux
+
+package diskusage
+
+import (
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+const (
+	// Количество одновременно выполняемых вызовов statfs.
+	statfsWorkers = 4
+	// Время ожидания statfs, после которого точка монтирования считается зависшей.
+	statfsTimeout = 500 * time.Millisecond
+	// Дополнительное время ожидания опросов, чтобы опрос, запущенный одновременно со сбором,
+	// успел сообщить о таймауте в том же цикле.
+	probeGrace      = 100 * time.Millisecond
+	staleBackoffMin = 2 * time.Second
+	staleBackoffMax = 5 * time.Minute
+)
+
+// mountState - состояние опроса одной точки монтирования.
+type mountState struct {
+	inFlight  bool
+	timedOut  bool
+	failures  int
+	nextProbe time.Time
+	last      *disk.UsageStat
+}
+
+// probeResult - результат опроса. timedOut означает, что statfs был запущен и не ответил
+// за statfsTimeout, результат самого вызова придет отдельным сообщением.
+type probeResult struct {
+	mountpoint string
+	stat       *disk.UsageStat
+	err        error
+	timedOut   bool
+}
+
+func (s *mountState) stale() bool {
+	return s.failures > 0
+}
+
+func (s *mountState) fail(now time.Time) {
+	s.failures++
+	backoff := staleBackoffMin << (s.failures - 1)
+	if backoff > staleBackoffMax || backoff <= 0 {
+		backoff = staleBackoffMax
+	}
+	s.nextProbe = now.Add(backoff)
+}
+
+// report возвращает последние известные данные, помеченные признаком устаревания.
+func (s *mountState) report(p PartitionStat) *disk.UsageStat {
+	stat := &disk.UsageStat{Device: p.Device, Mountpoint: p.Mountpoint}
+	if s.last != nil {
+		c := *s.last
+		stat = &c
+	}
+	// Тип из таблицы монтирования точнее, чем определенный по магическому числу
+	if p.FsType != "" {
+		stat.Type = p.FsType
+	}
+	stat.ReadOnly = stat.ReadOnly || utils.StringsHas(p.Opts, "ro")
+	stat.Stale = s.stale()
+	return stat
+}
+
+func (l *LinuxDiskUsageCollector) mountState(mountpoint string) *mountState {
+	s, ok := l.mounts[mountpoint]
+	if !ok {
+		s = &mountState{}
+		l.mounts[mountpoint] = s
+	}
+	return s
+}
+
+// probe выполняет statfs в отдельной горутине. Таймаут отсчитывается с момента запуска statfs,
+// а не с момента постановки в очередь. Зависший вызов освобождает слот по таймауту и сообщает
+// о нем, а его результат будет обработан, когда точка монтирования ответит.
+func (l *LinuxDiskUsageCollector) probe(p PartitionStat) {
+	l.workers <- struct{}{}
+	done := make(chan probeResult, 1)
+	go func() {
+		stat, err := l.GetDiskUsageStat(p.Device, p.Mountpoint)
+		done <- probeResult{mountpoint: p.Mountpoint, stat: stat, err: err}
+	}()
+
+	timer := time.NewTimer(statfsTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		<-l.workers
+		l.results <- r
+	case <-timer.C:
+		<-l.workers
+		l.results <- probeResult{mountpoint: p.Mountpoint, timedOut: true}
+		l.results <- <-done
+	}
+}
+
+func (l *LinuxDiskUsageCollector) handleResult(r probeResult) {
+	s := l.mountState(r.mountpoint)
+	if r.timedOut {
+		l.l.Warn("mount point does not respond, marked as stale", "path", r.mountpoint)
+		s.timedOut = true
+		s.fail(time.Now())
+		return
+	}
+	s.inFlight = false
+	timedOut := s.timedOut
+	s.timedOut = false
+	if r.err != nil {
+		l.l.Error("failed to get disk usage stat", "error", r.err.Error(), "path", r.mountpoint)
+		if !timedOut {
+			s.fail(time.Now())
+		}
+		return
+	}
+	if s.failures > 0 {
+		l.l.Info("mount point recovered", "path", r.mountpoint)
+	}
+	s.failures = 0
+	s.nextProbe = time.Time{}
+	s.last = r.stat
+}
+
+// collect опрашивает точки монтирования, не блокируясь дольше statfsTimeout и probeGrace.
+// Зависшие и недоступные точки монтирования возвращаются с признаком Stale. Опрос, который
+// не успел получить слот, остается в очереди и учитывается в следующих циклах.
+func (l *LinuxDiskUsageCollector) collect(devices []PartitionStat) disk.UsageStatMap {
+	if l.results == nil {
+		// На каждую точку монтирования приходится не более одного незавершенного опроса,
+		// который может прислать сообщение о таймауте и результат
+		l.results = make(chan probeResult, 2*len(devices))
+	}
+
+	// Результаты опросов, завершившихся после таймаута
+	for drained := false; !drained; {
+		select {
+		case r := <-l.results:
+			l.handleResult(r)
+		default:
+			drained = true
+		}
+	}
+
+	now := time.Now()
+	launched := make(map[string]struct{})
+	for _, p := range devices {
+		s := l.mountState(p.Mountpoint)
+		if s.inFlight || now.Before(s.nextProbe) {
+			continue
+		}
+		s.inFlight = true
+		launched[p.Mountpoint] = struct{}{}
+		go l.probe(p)
+	}
+
+	deadline := time.NewTimer(statfsTimeout + probeGrace)
+	defer deadline.Stop()
+L:
+	for len(launched) > 0 {
+		select {
+		case r := <-l.results:
+			l.handleResult(r)
+			delete(launched, r.mountpoint)
+		case <-deadline.C:
+			break L
+		}
+	}
+
+	message := make(disk.UsageStatMap)
+	for _, p := range devices {
+		message[p.Mountpoint] = l.mountState(p.Mountpoint).report(p)
+	}
+	return message
+}
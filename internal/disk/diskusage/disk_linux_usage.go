@@ -44,6 +44,9 @@ type LinuxDiskUsageCollector struct {
 	clientCtx context.Context
 	cfg       *config.DaemonConfig
 	l         logger.Logger
+	mounts    map[string]*mountState
+	workers   chan struct{}
+	results   chan probeResult
 }
 
 func NewLinuxDiskUsageCollector(
@@ -54,6 +57,8 @@ func NewLinuxDiskUsageCollector(
 		clientCtx: clientCtx,
 		cfg:       cfg,
 		l:         l,
+		mounts:    make(map[string]*mountState),
+		workers:   make(chan struct{}, statfsWorkers),
 	}
 }
 
@@ -87,6 +92,17 @@ func (l *LinuxDiskUsageCollector) readFileSystems() ([]string, error) {
 	return lines, nil
 }
 
+// nodevFsTypes - файловые системы без блочного устройства, использование которых собирается:
+// zfs и сетевые. Для fuse в таблице монтирования указывается тип с подтипом, например fuse.sshfs.
+var nodevFsTypes = map[string][]string{
+	"zfs":  {"zfs"},
+	"nfs":  {"nfs"},
+	"nfs4": {"nfs4"},
+	"cifs": {"cifs"},
+	"smb3": {"smb3"},
+	"fuse": {"fuse.sshfs"},
+}
+
 func (l *LinuxDiskUsageCollector) getFileSystems() ([]string, error) {
 	lines, err := l.readFileSystems()
 	if err != nil {
@@ -99,10 +115,10 @@ func (l *LinuxDiskUsageCollector) getFileSystems() ([]string, error) {
 			continue
 		}
 		t := strings.Split(line, "\t")
-		if len(t) != 2 || t[1] != "zfs" {
+		if len(t) != 2 {
 			continue
 		}
-		ret = append(ret, strings.TrimSpace(t[1]))
+		ret = append(ret, nodevFsTypes[strings.TrimSpace(t[1])]...)
 	}
 
 	return ret, nil
@@ -123,10 +139,15 @@ func (l *LinuxDiskUsageCollector) GetDiskUsageStat(device, mountpoint string) (*
 		Device:     device,
 		Mountpoint: disk.UnescapeFstab(mountpoint),
 		Type:       disk.GetFsType(stat),
+		ReadOnly:   stat.Flags&unix.ST_RDONLY != 0,
 		INodeCount: float64(inodesTotal),
+		Total:      stat.Blocks * uint64(blockSize),
+		Used:       (stat.Blocks - stat.Bfree) * uint64(blockSize),
+		Free:       free,
+		INodeFree:  inodesFree,
 	}
 
-	ret.Usage = float64((stat.Blocks - stat.Bfree) * uint64(blockSize))
+	ret.Usage = float64(ret.Used)
 
 	if (ret.Usage + float64(free)) == 0 {
 		ret.UsagePercent = 0
@@ -139,6 +160,7 @@ func (l *LinuxDiskUsageCollector) GetDiskUsageStat(device, mountpoint string) (*
 	}
 
 	inodesUsed := inodesTotal - inodesFree
+	ret.INodeUsed = inodesUsed
 
 	if inodesTotal == 0 {
 		ret.INodeAvailablePercent = 0
@@ -149,6 +171,52 @@ func (l *LinuxDiskUsageCollector) GetDiskUsageStat(device, mountpoint string) (*
 	return ret, nil
 }
 
+type matchFunc func(pattern, value string) bool
+
+func matchName(pattern, value string) bool {
+	ok, _ := filepath.Match(pattern, value)
+	return ok
+}
+
+// matchPath для шаблонов с подстановками дополнительно считает совпадением вложенные точки монтирования:
+// /snap/* покрывает /snap/core/1. Шаблон без подстановок сравнивается точно.
+func matchPath(pattern, value string) bool {
+	if !strings.ContainsAny(pattern, "*?[") {
+		return filepath.Clean(pattern) == filepath.Clean(value)
+	}
+	for p := filepath.Clean(value); ; p = filepath.Dir(p) {
+		if matchName(pattern, p) {
+			return true
+		}
+		if p == filepath.Dir(p) {
+			return false
+		}
+	}
+}
+
+func matchAny(patterns []string, value string, match matchFunc) bool {
+	for _, pattern := range patterns {
+		if match(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowed(include, exclude []string, value string, match matchFunc) bool {
+	if len(include) > 0 && !matchAny(include, value, match) {
+		return false
+	}
+	return !matchAny(exclude, value, match)
+}
+
+// partitionAllowed проверяет раздел по правилам отбора из конфигурации.
+func partitionAllowed(f config.DiskUsageFilter, p PartitionStat) bool {
+	return allowed(f.IncludeMountpoints, f.ExcludeMountpoints, p.Mountpoint, matchPath) &&
+		allowed(f.IncludeDevices, f.ExcludeDevices, p.Device, matchName) &&
+		allowed(f.IncludeFsTypes, f.ExcludeFsTypes, p.FsType, matchName)
+}
+
 func (l *LinuxDiskUsageCollector) Partitions(all bool) ([]PartitionStat, error) { //nolint:gocognit
 	root := filepath.Join(l.cfg.System.Proc, path.Join("1"))
 	hpmPath := l.cfg.System.ProcMountInfo
@@ -238,6 +306,9 @@ func (l *LinuxDiskUsageCollector) Partitions(all bool) ([]PartitionStat, error)
 				}
 			}
 		}
+		if !partitionAllowed(l.cfg.DiskUsage, d) {
+			continue
+		}
 		ret = append(ret, d)
 	}
 
@@ -251,7 +322,7 @@ func (l *LinuxDiskUsageCollector) Run() (<-chan disk.UsageStatMap, error) {
 		return nil, err
 	}
 	ch := make(chan disk.UsageStatMap)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorDiskUsage))
 
 	go func() {
 		defer close(ch)
@@ -265,21 +336,42 @@ func (l *LinuxDiskUsageCollector) Run() (<-chan disk.UsageStatMap, error) {
 				if !l.cfg.Metrics.DiskUsage {
 					continue
 				}
-				message := make(disk.UsageStatMap)
-				for _, device := range devices {
-					stat, err := l.GetDiskUsageStat(device.Device, device.Mountpoint)
-					if err != nil {
-						l.l.Error(
-							"failed to get disk usage stat", "error", err.Error(), "path", device.Mountpoint,
-						)
-						l.cfg.Metrics.DiskUsage = false
-						continue
-					}
-					message[device.Mountpoint] = stat
-				}
-				ch <- message
+				ch <- l.collect(devices)
 			}
 		}
 	}()
 	return ch, nil
 }
+
+// Follow передает клиенту измерения общего сборщика feed с периодом disk_usage. Пока общий сборщик
+// не выполнил первый опрос, измерения не передаются.
+func Follow(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, feed *disk.UsageFeed, l logger.Logger,
+) <-chan disk.UsageStatMap {
+	ch := make(chan disk.UsageStatMap)
+	ticker := time.NewTicker(cfg.Interval(config.CollectorDiskUsage))
+
+	go func() {
+		defer close(ch)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-serverCtx.Done():
+				return
+			case <-clientCtx.Done():
+				l.Debug("disk usage collector stopped")
+				return
+			case <-ticker.C:
+				stat := feed.Latest()
+				if !cfg.Metrics.DiskUsage || stat == nil {
+					continue
+				}
+				select {
+				case ch <- stat:
+				case <-clientCtx.Done():
+				}
+			}
+		}
+	}()
+	return ch
+}
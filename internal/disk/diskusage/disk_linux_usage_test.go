@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -128,3 +130,259 @@ func TestDiskUsageStat(t *testing.T) {
 		require.True(t, cfg.Metrics.DiskUsage)
 	})
 }
+
+func TestDiskUsagePartitionsFilter(t *testing.T) {
+	log.Disable()
+
+	proc := t.TempDir()
+	mountInfo := filepath.Join(proc, "1", "mountinfo")
+	require.NoError(t, os.MkdirAll(filepath.Dir(mountInfo), 0o755))
+	require.NoError(t, os.WriteFile(mountInfo, []byte(
+		"26 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n"+
+			"27 26 8:2 / /home rw,relatime shared:2 - xfs /dev/sda2 rw\n"+
+			"28 26 7:0 / /snap/core/1 ro,nodev shared:3 - squashfs /dev/loop0 ro\n"+
+			"29 26 0:5 / /dev/shm rw,nosuid - tmpfs tmpfs rw\n",
+	), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(proc, "filesystems"), []byte(
+		"\text4\n\txfs\n\tsquashfs\nnodev\ttmpfs\n",
+	), 0o600))
+
+	mountpoints := func(f config.DiskUsageFilter) []string {
+		cfg := createConfig()
+		cfg.System.Proc = proc
+		cfg.System.ProcMountInfo = mountInfo
+		cfg.DiskUsage = f
+		v := NewLinuxDiskUsageCollector(context.TODO(), context.TODO(), cfg, log)
+		partitions, err := v.Partitions(false)
+		require.NoError(t, err)
+		result := make([]string, 0, len(partitions))
+		for _, p := range partitions {
+			result = append(result, p.Mountpoint)
+		}
+		return result
+	}
+
+	t.Run("disk usage: no filters", func(t *testing.T) {
+		require.Equal(t, []string{"/", "/home", "/snap/core/1"}, mountpoints(config.DiskUsageFilter{}))
+	})
+
+	t.Run("disk usage: exclude rules", func(t *testing.T) {
+		require.Equal(t, []string{"/"}, mountpoints(config.DiskUsageFilter{
+			ExcludeMountpoints: []string{"/snap/*"},
+			ExcludeDevices:     []string{"/dev/sda2"},
+		}))
+		require.Equal(t, []string{"/", "/home"}, mountpoints(config.DiskUsageFilter{
+			ExcludeFsTypes: []string{"squashfs"},
+		}))
+	})
+
+	t.Run("disk usage: include rules", func(t *testing.T) {
+		require.Equal(t, []string{"/home"}, mountpoints(config.DiskUsageFilter{
+			IncludeFsTypes: []string{"xfs", "btrfs"},
+		}))
+		require.Equal(t, []string{"/"}, mountpoints(config.DiskUsageFilter{
+			IncludeMountpoints: []string{"/"},
+		}))
+		require.Equal(t, []string{"/", "/home"}, mountpoints(config.DiskUsageFilter{
+			IncludeDevices:     []string{"/dev/sd*"},
+			ExcludeMountpoints: []string{"/snap/*"},
+		}))
+	})
+}
+
+func TestDiskUsageNetworkMounts(t *testing.T) {
+	log.Disable()
+
+	proc := t.TempDir()
+	mountInfo := filepath.Join(proc, "1", "mountinfo")
+	require.NoError(t, os.MkdirAll(filepath.Dir(mountInfo), 0o755))
+	require.NoError(t, os.WriteFile(mountInfo, []byte(
+		"26 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n"+
+			"30 26 0:50 / /mnt/nfs rw,relatime - nfs4 nas:/export rw,vers=4.2\n"+
+			"31 26 0:51 / /mnt/share rw,relatime - cifs //nas/share rw,vers=3.0\n"+
+			"32 26 0:52 / /mnt/ssh rw,nosuid - fuse.sshfs user@host:/home rw,user_id=0\n"+
+			"33 26 0:53 / /mnt/smb rw,relatime - smb3 //nas/smb rw\n"+
+			"34 26 0:54 / /run/user/1000/doc rw,nosuid - fuse.portal portal rw\n"+
+			"35 26 0:5 / /dev/shm rw,nosuid - tmpfs tmpfs rw\n",
+	), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(proc, "filesystems"), []byte(
+		"\text4\nnodev\ttmpfs\nnodev\tnfs\nnodev\tnfs4\nnodev\tcifs\nnodev\tsmb3\nnodev\tfuse\n",
+	), 0o600))
+
+	cfg := createConfig()
+	cfg.System.Proc = proc
+	cfg.System.ProcMountInfo = mountInfo
+	v := NewLinuxDiskUsageCollector(context.TODO(), context.TODO(), cfg, log)
+	partitions, err := v.Partitions(false)
+	require.NoError(t, err)
+	result := make([]string, 0, len(partitions))
+	for _, p := range partitions {
+		result = append(result, p.FsType+" "+p.Mountpoint)
+	}
+	require.Equal(t, []string{
+		"ext4 /", "nfs4 /mnt/nfs", "cifs /mnt/share", "fuse.sshfs /mnt/ssh", "smb3 /mnt/smb",
+	}, result)
+}
+
+func TestDiskUsageStaleMounts(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	release := make(chan struct{})
+
+	patches := gomonkey.NewPatches()
+	patches.ApplyMethodFunc(
+		&LinuxDiskUsageCollector{}, "GetDiskUsageStat", func(device, mountpoint string) (*disk.UsageStat, error) {
+			mu.Lock()
+			calls[mountpoint]++
+			mu.Unlock()
+			switch mountpoint {
+			case "/hung":
+				<-release
+			case "/bad":
+				return nil, fmt.Errorf("error")
+			}
+			return &disk.UsageStat{Device: device, Mountpoint: mountpoint, Total: 100}, nil
+		})
+	t.Cleanup(func() { patches.Reset() })
+
+	callsOf := func(mountpoint string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[mountpoint]
+	}
+
+	devices := []PartitionStat{
+		{Device: "/dev/sda1", Mountpoint: "/ok", FsType: "ext4"},
+		{Device: "server:/export", Mountpoint: "/hung", FsType: "nfs"},
+		{Device: "//server/share", Mountpoint: "/bad", FsType: "cifs"},
+	}
+	v := NewLinuxDiskUsageCollector(context.TODO(), context.TODO(), createConfig(), log)
+
+	t.Run("disk usage: hung mount does not block collection", func(t *testing.T) {
+		start := time.Now()
+		val := v.collect(devices)
+		require.Less(t, time.Since(start), statfsTimeout+200*time.Millisecond)
+
+		require.Len(t, val, 3)
+		require.False(t, val["/ok"].Stale)
+		require.Equal(t, uint64(100), val["/ok"].Total)
+		require.True(t, val["/hung"].Stale)
+		require.Equal(t, "nfs", val["/hung"].Type)
+		require.True(t, val["/bad"].Stale)
+	})
+
+	t.Run("disk usage: stale mounts are not probed again", func(t *testing.T) {
+		val := v.collect(devices)
+		require.True(t, val["/hung"].Stale)
+		require.True(t, val["/bad"].Stale)
+		require.Equal(t, 2, callsOf("/ok"))
+		require.Equal(t, 1, callsOf("/hung"))
+		require.Equal(t, 1, callsOf("/bad"))
+	})
+
+	t.Run("disk usage: mount recovers", func(t *testing.T) {
+		close(release)
+		require.Eventually(t, func() bool {
+			return !v.collect(devices)["/hung"].Stale
+		}, 2*time.Second, 10*time.Millisecond)
+		require.True(t, v.collect(devices)["/bad"].Stale)
+	})
+}
+
+func TestDiskUsageQueuedProbes(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	release := make(chan struct{})
+
+	patches := gomonkey.NewPatches()
+	patches.ApplyMethodFunc(
+		&LinuxDiskUsageCollector{}, "GetDiskUsageStat", func(device, mountpoint string) (*disk.UsageStat, error) {
+			mu.Lock()
+			calls[mountpoint]++
+			mu.Unlock()
+			if mountpoint != "/ok" {
+				<-release
+			}
+			return &disk.UsageStat{Device: device, Mountpoint: mountpoint, Total: 100}, nil
+		})
+	t.Cleanup(func() { patches.Reset() })
+
+	callsOf := func(mountpoint string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[mountpoint]
+	}
+
+	// Зависших точек монтирования больше, чем слотов для statfs
+	devices := []PartitionStat{{Device: "/dev/sda1", Mountpoint: "/ok", FsType: "ext4"}}
+	for i := 0; i <= statfsWorkers; i++ {
+		devices = append(devices, PartitionStat{
+			Device: fmt.Sprintf("server:/export%d", i), Mountpoint: fmt.Sprintf("/hung%d", i), FsType: "nfs",
+		})
+	}
+	v := NewLinuxDiskUsageCollector(context.TODO(), context.TODO(), createConfig(), log)
+
+	t.Run("disk usage: queued probes are not marked as stale", func(t *testing.T) {
+		val := v.collect(devices)
+		require.False(t, val["/ok"].Stale)
+		stale := 0
+		for _, p := range devices[1:] {
+			// Точка монтирования помечается, только если statfs был запущен
+			if val[p.Mountpoint].Stale {
+				stale++
+				require.Equal(t, 1, callsOf(p.Mountpoint), p.Mountpoint)
+			}
+		}
+		require.Equal(t, statfsWorkers, stale)
+	})
+
+	t.Run("disk usage: queued probes run after slots are released", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			val := v.collect(devices)
+			for _, p := range devices[1:] {
+				if !val[p.Mountpoint].Stale {
+					return false
+				}
+			}
+			return !val["/ok"].Stale && val["/ok"].Total == 100
+		}, 3*time.Second, 10*time.Millisecond)
+		for _, p := range devices[1:] {
+			require.Equal(t, 1, callsOf(p.Mountpoint), p.Mountpoint)
+		}
+	})
+
+	close(release)
+}
+
+func TestDiskUsageFollow(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	log.Disable()
+
+	cfg := createConfig()
+	cfg.Intervals = config.Intervals{config.CollectorDiskUsage: 100 * time.Millisecond}
+	feed := &disk.UsageFeed{}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Follow(ctx, ctx, cfg, feed, log)
+
+	// До первого опроса общего сборщика измерения не передаются
+	select {
+	case <-ch:
+		t.Fatal("unexpected stat before the first probe")
+	case <-time.After(250 * time.Millisecond):
+	}
+
+	stat := disk.UsageStatMap{"/": {Mountpoint: "/", Total: 100}}
+	feed.Set(stat)
+	require.Equal(t, stat, <-ch)
+	require.Equal(t, stat, <-ch)
+
+	cancel()
+	for range ch {
+	}
+}
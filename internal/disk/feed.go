@@ -0,0 +1,24 @@
+package disk
+
+import "sync"
+
+// UsageFeed - последнее измерение использования файловых систем, полученное общим сборщиком демона.
+// Потоки клиентов читают измерения из него, не запуская собственные вызовы statfs.
+type UsageFeed struct {
+	mu     sync.RWMutex
+	latest UsageStatMap
+}
+
+func (f *UsageFeed) Set(stat UsageStatMap) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latest = stat
+}
+
+// Latest возвращает последнее измерение или nil, если измерений еще не было.
+// Измерение используется несколькими потоками и не должно изменяться.
+func (f *UsageFeed) Latest() UsageStatMap {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.latest
+}
@@ -0,0 +1,142 @@
+package forecast
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Минимальное количество измерений для построения прогноза.
+	minSamples = 3
+	// Измерения должны покрывать не менее 1/minCoverage окна.
+	minCoverage = 4
+)
+
+type Sample struct {
+	Time       time.Time
+	Used       uint64
+	Free       uint64
+	INodesUsed uint64
+	INodesFree uint64
+}
+
+// Estimate - прогноз заполнения по одному окну. Нулевое TimeToFull означает,
+// что рост не обнаружен или данных недостаточно.
+type Estimate struct {
+	Window       time.Duration
+	BytesPerSec  float64
+	INodesPerSec float64
+	TimeToFull   time.Duration
+}
+
+// History хранит историю использования файловых систем с шагом не чаще resolution
+// и глубиной maxAge. Безопасна для использования из нескольких горутин.
+type History struct {
+	mu         sync.RWMutex
+	samples    map[string][]Sample
+	maxAge     time.Duration
+	resolution time.Duration
+}
+
+func NewHistory(maxAge, resolution time.Duration) *History {
+	return &History{
+		samples:    make(map[string][]Sample),
+		maxAge:     maxAge,
+		resolution: resolution,
+	}
+}
+
+func (h *History) Add(mountpoint string, s Sample) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := h.samples[mountpoint]
+	if n := len(samples); n > 0 && s.Time.Sub(samples[n-1].Time) < h.resolution {
+		return
+	}
+	samples = append(samples, s)
+
+	i := 0
+	for i < len(samples) && s.Time.Sub(samples[i].Time) > h.maxAge {
+		i++
+	}
+	h.samples[mountpoint] = samples[i:]
+}
+
+// Forecast строит прогноз по измерениям, попавшим в окно window, отсчитанное от now.
+func (h *History) Forecast(mountpoint string, window time.Duration, now time.Time) Estimate {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result := Estimate{Window: window}
+	samples := h.samples[mountpoint]
+	i := len(samples)
+	for i > 0 && now.Sub(samples[i-1].Time) <= window {
+		i--
+	}
+	samples = samples[i:]
+	if len(samples) < minSamples || samples[len(samples)-1].Time.Sub(samples[0].Time) < window/minCoverage {
+		return result
+	}
+
+	result.BytesPerSec = slope(samples, func(s Sample) float64 { return float64(s.Used) })
+	result.INodesPerSec = slope(samples, func(s Sample) float64 { return float64(s.INodesUsed) })
+
+	last := samples[len(samples)-1]
+	result.TimeToFull = minPositive(
+		timeToFull(float64(last.Free), result.BytesPerSec),
+		timeToFull(float64(last.INodesFree), result.INodesPerSec),
+	)
+	return result
+}
+
+// slope - наклон линейной регрессии методом наименьших квадратов, единиц в секунду.
+func slope(samples []Sample, value func(Sample) float64) float64 {
+	n := float64(len(samples))
+	var sumX, sumY float64
+	for _, s := range samples {
+		sumX += s.Time.Sub(samples[0].Time).Seconds()
+		sumY += value(s)
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var num, den float64
+	for _, s := range samples {
+		dx := s.Time.Sub(samples[0].Time).Seconds() - meanX
+		num += dx * (value(s) - meanY)
+		den += dx * dx
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+func timeToFull(free, rate float64) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	return time.Duration(free / rate * float64(time.Second))
+}
+
+func minPositive(a, b time.Duration) time.Duration {
+	switch {
+	case a <= 0:
+		return b
+	case b <= 0:
+		return a
+	case a < b:
+		return a
+	default:
+		return b
+	}
+}
+
+// Soonest возвращает минимальное ненулевое время до заполнения среди прогнозов.
+func Soonest(estimates []Estimate) time.Duration {
+	result := time.Duration(0)
+	for _, e := range estimates {
+		result = minPositive(result, e.TimeToFull)
+	}
+	return result
+}
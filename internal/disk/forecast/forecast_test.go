@@ -0,0 +1,80 @@
+package forecast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecast(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("linear growth", func(t *testing.T) {
+		h := NewHistory(24*time.Hour, time.Minute)
+		// Растет на 1000 байт в минуту, свободно 60000 байт в последнем измерении
+		for i := 0; i <= 60; i++ {
+			h.Add("/", Sample{
+				Time: start.Add(time.Duration(i) * time.Minute),
+				Used: uint64(1000 * i), Free: uint64(120000 - 1000*i),
+				INodesUsed: 10, INodesFree: 100,
+			})
+		}
+		now := start.Add(time.Hour)
+
+		e := h.Forecast("/", time.Hour, now)
+		require.Equal(t, time.Hour, e.Window)
+		require.InDelta(t, 1000.0/60.0, e.BytesPerSec, 0.0001)
+		require.InDelta(t, 0, e.INodesPerSec, 0.0001)
+		require.InDelta(t, time.Hour.Seconds(), e.TimeToFull.Seconds(), 1)
+	})
+
+	t.Run("inodes run out first", func(t *testing.T) {
+		h := NewHistory(time.Hour, time.Second)
+		for i := 0; i <= 20; i++ {
+			h.Add("/var", Sample{
+				Time: start.Add(time.Duration(i) * time.Minute),
+				Used: uint64(60 * i), Free: 1 << 40,
+				INodesUsed: uint64(60 * i), INodesFree: 600,
+			})
+		}
+		e := h.Forecast("/var", time.Hour, start.Add(20*time.Minute))
+		require.InDelta(t, 1.0, e.INodesPerSec, 0.0001)
+		require.InDelta(t, 600, e.TimeToFull.Seconds(), 1)
+	})
+
+	t.Run("no growth", func(t *testing.T) {
+		h := NewHistory(time.Hour, time.Second)
+		for i := 0; i <= 30; i++ {
+			h.Add("/", Sample{Time: start.Add(time.Duration(i) * time.Minute), Used: uint64(1000 - i), Free: 100})
+		}
+		e := h.Forecast("/", time.Hour, start.Add(30*time.Minute))
+		require.Less(t, e.BytesPerSec, 0.0)
+		require.Zero(t, e.TimeToFull)
+	})
+
+	t.Run("not enough data", func(t *testing.T) {
+		h := NewHistory(24*time.Hour, time.Second)
+		for i := 0; i <= 20; i++ {
+			h.Add("/", Sample{Time: start.Add(time.Duration(i) * time.Minute), Used: uint64(i), Free: 100})
+		}
+		now := start.Add(20 * time.Minute)
+		require.NotZero(t, h.Forecast("/", time.Hour, now).TimeToFull)
+		require.Zero(t, h.Forecast("/", 24*time.Hour, now).TimeToFull)
+		require.Zero(t, h.Forecast("/unknown", time.Hour, now).TimeToFull)
+	})
+
+	t.Run("resolution and max age", func(t *testing.T) {
+		h := NewHistory(10*time.Minute, time.Minute)
+		for i := 0; i < 30*60; i++ {
+			h.Add("/", Sample{Time: start.Add(time.Duration(i) * time.Second)})
+		}
+		require.Len(t, h.samples["/"], 11)
+		require.Equal(t, start.Add(19*time.Minute), h.samples["/"][0].Time)
+	})
+
+	t.Run("soonest", func(t *testing.T) {
+		require.Equal(t, time.Hour, Soonest([]Estimate{{TimeToFull: 0}, {TimeToFull: 2 * time.Hour}, {TimeToFull: time.Hour}}))
+		require.Zero(t, Soonest(nil))
+	})
+}
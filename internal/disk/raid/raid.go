@@ -0,0 +1,49 @@
+package raid
+
+import (
+	"encoding/json"
+)
+
+const (
+	MemberInSync      = "in_sync"
+	MemberFaulty      = "faulty"
+	MemberSpare       = "spare"
+	MemberReplacement = "replacement"
+)
+
+// Member - устройство в составе массива. Disk - физический диск, на котором оно расположено.
+type Member struct {
+	Device string
+	Disk   string
+	Role   int
+	State  string
+}
+
+// Array - программный RAID из /proc/mdstat. DisksTotal и DisksActive - ожидаемое и фактическое
+// число рабочих устройств. SyncProgress - процент выполнения операции SyncAction
+// (resync, recovery, reshape, check), SyncFinish - оценка оставшегося времени в секундах.
+type Array struct {
+	Name         string
+	State        string
+	Level        string
+	Members      []Member
+	DisksTotal   int
+	DisksActive  int
+	Degraded     bool
+	SyncAction   string
+	SyncProgress float64
+	SyncFinish   float64
+	SyncSpeed    uint64
+}
+
+type Stat []Array
+
+func (s Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan Stat, error)
+	Get() (Stat, error)
+}
@@ -0,0 +1,194 @@
+//go:build linux
+
+package raid
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+var (
+	// sdb1[1](F)
+	memberRe = regexp.MustCompile(`^([^\[]+)\[(\d+)\](?:\(([A-Z])\))?$`)
+	// [2/1] [U_]
+	disksRe = regexp.MustCompile(`\[(\d+)/(\d+)\]\s+\[([U_]+)\]`)
+	// recovery = 12.6% (37043392/293039104) finish=127.5min speed=33440K/sec
+	syncRe = regexp.MustCompile(`(resync|recovery|reshape|check|repair)\s*=\s*([\d.]+)%`)
+	// resync=DELAYED, resync=PENDING
+	syncPendingRe = regexp.MustCompile(`(resync|recovery|reshape|check|repair)\s*=\s*(DELAYED|PENDING)`)
+	finishRe      = regexp.MustCompile(`finish=([\d.]+)min`)
+	speedRe       = regexp.MustCompile(`speed=(\d+)K/sec`)
+)
+
+var memberStates = map[string]string{"F": MemberFaulty, "S": MemberSpare, "R": MemberReplacement}
+
+type LinuxRAIDCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+}
+
+func NewLinuxRAIDCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxRAIDCollector {
+	return &LinuxRAIDCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxRAIDCollector) Run() (<-chan Stat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("raid collector error", "error", err.Error())
+		l.cfg.Metrics.RAID = false
+		return nil, err
+	}
+	ch := make(chan Stat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorRAID))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("raid collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.RAID {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("raid collector error", "error", err.Error())
+					l.cfg.Metrics.RAID = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает состояние массивов. Если модуль md не загружен, /proc/mdstat отсутствует
+// и метрика отключается.
+func (l *LinuxRAIDCollector) Get() (Stat, error) {
+	lines, err := utils.ReadLines(filepath.Join(l.cfg.System.Proc, "mdstat"))
+	if err != nil {
+		return nil, err
+	}
+	stat, err := parseMdstat(lines)
+	if err != nil {
+		return nil, err
+	}
+	// Без топологии диски участников остаются пустыми
+	topology, _ := disk.GetTopology(l.cfg.System.Sys)
+	for i := range stat {
+		for j := range stat[i].Members {
+			m := &stat[i].Members[j]
+			m.Disk = strings.Join(topology.Disks(m.Device), ",")
+		}
+	}
+	return stat, nil
+}
+
+// parseMdstat разбирает /proc/mdstat. Описание массива начинается со строки "md0 : active raid1 ...",
+// следующие строки с отступом содержат число устройств и ход синхронизации.
+func parseMdstat(lines []string) (Stat, error) {
+	result := make(Stat, 0)
+	var cur *Array
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case strings.HasPrefix(line, "Personalities") || strings.HasPrefix(line, "unused devices"):
+			cur = nil
+		case len(fields) >= 3 && fields[1] == ":":
+			a, err := parseArrayLine(fields)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, a)
+			cur = &result[len(result)-1]
+		case cur != nil:
+			parseStatusLine(cur, line)
+		}
+	}
+	for i := range result {
+		a := &result[i]
+		for _, m := range a.Members {
+			if m.State == MemberFaulty {
+				a.Degraded = true
+			}
+		}
+		if a.DisksActive < a.DisksTotal {
+			a.Degraded = true
+		}
+	}
+	return result, nil
+}
+
+// parseArrayLine разбирает строку "md0 : active (auto-read-only) raid1 sdb1[1] sda1[0](F)".
+// У неактивного массива уровень не указывается.
+func parseArrayLine(fields []string) (Array, error) {
+	a := Array{Name: fields[0], State: fields[2]}
+	rest := fields[3:]
+	for len(rest) > 0 && strings.HasPrefix(rest[0], "(") {
+		a.State += " " + rest[0]
+		rest = rest[1:]
+	}
+	if len(rest) > 0 && !memberRe.MatchString(rest[0]) {
+		a.Level = rest[0]
+		rest = rest[1:]
+	}
+	for _, f := range rest {
+		m := memberRe.FindStringSubmatch(f)
+		if m == nil {
+			return Array{}, fmt.Errorf("invalid mdstat member %q of %s", f, a.Name)
+		}
+		role, _ := strconv.Atoi(m[2])
+		state, ok := memberStates[m[3]]
+		if !ok {
+			state = MemberInSync
+		}
+		a.Members = append(a.Members, Member{Device: m[1], Role: role, State: state})
+	}
+	return a, nil
+}
+
+func parseStatusLine(a *Array, line string) {
+	if m := disksRe.FindStringSubmatch(line); m != nil {
+		a.DisksTotal, _ = strconv.Atoi(m[1])
+		a.DisksActive, _ = strconv.Atoi(m[2])
+	}
+	if m := syncRe.FindStringSubmatch(line); m != nil {
+		a.SyncAction = m[1]
+		a.SyncProgress, _ = strconv.ParseFloat(m[2], 64)
+	}
+	if m := syncPendingRe.FindStringSubmatch(line); m != nil {
+		a.SyncAction = m[1]
+	}
+	if m := finishRe.FindStringSubmatch(line); m != nil {
+		minutes, _ := strconv.ParseFloat(m[1], 64)
+		a.SyncFinish = minutes * 60
+	}
+	if m := speedRe.FindStringSubmatch(line); m != nil {
+		speed, _ := strconv.ParseUint(m[1], 10, 64)
+		a.SyncSpeed = speed * 1024
+	}
+}
@@ -0,0 +1,144 @@
+//go:build linux
+
+package raid
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+const fakeMdstat = `Personalities : [raid1] [raid6] [raid5] [raid4]
+md127 : active raid1 sdb1[1] sda1[0]
+      1046528 blocks super 1.2 [2/2] [UU]
+      bitmap: 0/1 pages [0KB], 65536KB chunk
+
+md1 : active raid5 sde[3] sdd[1] sdc[0](F) sdf[4](S)
+      585660416 blocks super 1.2 level 5, 512k chunk, algorithm 2 [3/2] [_UU]
+      [==>..................]  recovery = 12.6% (37043392/292830208) finish=127.5min speed=33440K/sec
+
+md2 : inactive sdg[0](S)
+      976631512 blocks super 1.2
+
+unused devices: <none>`
+
+func createConfig(proc, sys string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Proc: proc, Sys: sys},
+		Metrics: config.Metrics{RAID: true},
+	}
+}
+
+func fakeProc(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "mdstat"), []byte(fakeMdstat+"\n"), 0o600))
+	return root
+}
+
+// fakeSys создает /sys/class/block с разделами sda1 и sdb1 дисков sda и sdb.
+func fakeSys(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	class := filepath.Join(root, "class", "block")
+	require.NoError(t, os.MkdirAll(class, 0o755))
+	for _, d := range []string{"sda", "sdb"} {
+		part := filepath.Join(root, "devices", d, d+"1")
+		require.NoError(t, os.MkdirAll(part, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(part, "partition"), []byte("1\n"), 0o600))
+		require.NoError(t, os.Symlink(filepath.Join(root, "devices", d), filepath.Join(class, d)))
+		require.NoError(t, os.Symlink(part, filepath.Join(class, d+"1")))
+	}
+	return root
+}
+
+func TestRAID(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("raid: parser", func(t *testing.T) {
+		stat, err := parseMdstat(strings.Split(fakeMdstat, "\n"))
+		require.NoError(t, err)
+		require.Equal(t, Stat{
+			{
+				Name: "md127", State: "active", Level: "raid1", DisksTotal: 2, DisksActive: 2,
+				Members: []Member{
+					{Device: "sdb1", Role: 1, State: MemberInSync},
+					{Device: "sda1", Role: 0, State: MemberInSync},
+				},
+			},
+			{
+				Name: "md1", State: "active", Level: "raid5", DisksTotal: 3, DisksActive: 2, Degraded: true,
+				Members: []Member{
+					{Device: "sde", Role: 3, State: MemberInSync},
+					{Device: "sdd", Role: 1, State: MemberInSync},
+					{Device: "sdc", Role: 0, State: MemberFaulty},
+					{Device: "sdf", Role: 4, State: MemberSpare},
+				},
+				SyncAction: "recovery", SyncProgress: 12.6, SyncFinish: 7650, SyncSpeed: 33440 * 1024,
+			},
+			{
+				Name: "md2", State: "inactive",
+				Members: []Member{{Device: "sdg", Role: 0, State: MemberSpare}},
+			},
+		}, stat)
+
+		_, err = parseMdstat([]string{"md0 : active raid1 sda1[x]"})
+		require.Error(t, err)
+	})
+
+	t.Run("raid: faulty member", func(t *testing.T) {
+		stat, err := parseMdstat([]string{
+			"md0 : active (auto-read-only) raid1 sdb1[1](F) sda1[0]",
+			"      1046528 blocks super 1.2 [2/2] [UU]",
+		})
+		require.NoError(t, err)
+		require.Len(t, stat, 1)
+		require.Equal(t, "active (auto-read-only)", stat[0].State)
+		require.True(t, stat[0].Degraded)
+	})
+
+	t.Run("raid: Get()", func(t *testing.T) {
+		v := NewLinuxRAIDCollector(context.TODO(), context.TODO(), createConfig(fakeProc(t), fakeSys(t)), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Len(t, val, 3)
+		require.Equal(t, "sdb", val[0].Members[0].Disk)
+		require.Equal(t, "sda", val[0].Members[1].Disk)
+	})
+
+	t.Run("raid: Run() error", func(t *testing.T) {
+		cfg := createConfig(t.TempDir(), t.TempDir())
+		v := NewLinuxRAIDCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := v.Run()
+		require.Nil(t, ch)
+		require.Error(t, err)
+		require.False(t, cfg.Metrics.RAID)
+	})
+
+	t.Run("raid: metric enabled", func(t *testing.T) {
+		cfg := createConfig(fakeProc(t), fakeSys(t))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		v := NewLinuxRAIDCollector(ctx, ctx, cfg, log)
+
+		ch, err := v.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.Len(t, val, 3)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.RAID)
+	})
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// GetTopology строит топологию блочных устройств по /sys/class/block, где перечислены
+// и диски, и разделы. Раздел определяется по файлу partition, его диск - по каталогу,
+// в котором лежит раздел.
+func GetTopology(sysPath string) (Topology, error) {
+	root := filepath.Join(sysPath, "class", "block")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	result := make(Topology, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		dir, err := filepath.EvalSymlinks(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		d := Device{Name: name, Kind: KindDisk}
+		switch {
+		case exists(filepath.Join(dir, "partition")):
+			d.Kind = KindPartition
+			d.Parent = filepath.Base(filepath.Dir(dir))
+		case exists(filepath.Join(dir, "dm")):
+			d.Kind = KindDM
+			d.Label = readString(filepath.Join(dir, "dm", "name"))
+		case exists(filepath.Join(dir, "md")):
+			d.Kind = KindMD
+		case strings.HasPrefix(name, "loop"):
+			d.Kind = KindLoop
+		}
+		if slaves, err := os.ReadDir(filepath.Join(dir, "slaves")); err == nil {
+			for _, s := range slaves {
+				d.Slaves = append(d.Slaves, s.Name())
+			}
+			sort.Strings(d.Slaves)
+		}
+		result[name] = d
+	}
+	return result, nil
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func readString(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
@@ -0,0 +1,60 @@
+//go:build linux
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeBlockDevice(t *testing.T, root, path string, files map[string]string) {
+	t.Helper()
+	dir := filepath.Join(root, "devices", path)
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	for name, value := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(value+"\n"), 0o600))
+	}
+	link := filepath.Join(root, "class", "block", filepath.Base(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(link), 0o700))
+	require.NoError(t, os.Symlink(dir, link))
+}
+
+func TestTopology(t *testing.T) {
+	root := t.TempDir()
+	fakeBlockDevice(t, root, "pci/block/sda", nil)
+	fakeBlockDevice(t, root, "pci/block/sda/sda1", map[string]string{"partition": "1"})
+	fakeBlockDevice(t, root, "pci/block/sda/sda2", map[string]string{"partition": "2"})
+	fakeBlockDevice(t, root, "pci/block/sdb", nil)
+	fakeBlockDevice(t, root, "pci/block/sdb/sdb1", map[string]string{"partition": "1"})
+	fakeBlockDevice(t, root, "virtual/block/md127", map[string]string{
+		"md/level": "raid1", "slaves/sda1": "", "slaves/sdb1": "",
+	})
+	fakeBlockDevice(t, root, "virtual/block/dm-0", map[string]string{
+		"dm/name": "vg0-root", "slaves/md127": "",
+	})
+	fakeBlockDevice(t, root, "virtual/block/dm-1", map[string]string{
+		"dm/name": "vg1-data", "slaves/sda2": "",
+	})
+	fakeBlockDevice(t, root, "virtual/block/loop0", nil)
+
+	topology, err := GetTopology(root)
+	require.NoError(t, err)
+	require.Len(t, topology, 9)
+	require.Equal(t, Device{Name: "sda1", Kind: KindPartition, Parent: "sda"}, topology["sda1"])
+	require.Equal(t, Device{Name: "sda", Kind: KindDisk}, topology["sda"])
+	require.Equal(t, Device{Name: "md127", Kind: KindMD, Slaves: []string{"sda1", "sdb1"}}, topology["md127"])
+	require.Equal(t, Device{Name: "dm-0", Label: "vg0-root", Kind: KindDM, Slaves: []string{"md127"}}, topology["dm-0"])
+	require.Equal(t, KindLoop, topology["loop0"].Kind)
+
+	require.Equal(t, []string{"sda", "sdb"}, topology.Disks("dm-0"))
+	require.Equal(t, []string{"sda"}, topology.Disks("dm-1"))
+	require.Equal(t, []string{"sdb"}, topology.Disks("sdb1"))
+	require.Equal(t, []string{"sdx"}, topology.Disks("sdx"))
+
+	_, err = GetTopology(t.TempDir())
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package interrupts
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// IRQStat - скорость обработки прерывания в секунду по каждому процессору.
+type IRQStat struct {
+	IRQ    string
+	Device string
+	PerCPU []float64
+	Total  float64
+}
+
+type Stat []IRQStat
+
+func (s Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan Stat, error)
+	Get() (Stat, error)
+}
+
+// Balance - распределение прерывания по процессорам.
+type Balance struct {
+	TopCPU        int
+	TopCPUPercent float64
+	Imbalanced    bool
+}
+
+// CheckBalance находит процессор, обрабатывающий наибольшую долю прерывания. Дисбаланс фиксируется,
+// если доля превышает percent, а суммарная скорость не меньше minRate: редкие прерывания
+// естественно попадают на одно ядро. На однопроцессорной системе дисбаланса не бывает.
+func CheckBalance(perCPU []float64, percent, minRate float64) Balance {
+	result := Balance{}
+	total := 0.0
+	for i, v := range perCPU {
+		total += v
+		if v > perCPU[result.TopCPU] {
+			result.TopCPU = i
+		}
+	}
+	if total == 0 {
+		return result
+	}
+	result.TopCPUPercent = perCPU[result.TopCPU] / total * 100
+	result.Imbalanced = len(perCPU) > 1 && total >= minRate && result.TopCPUPercent > percent
+	return result
+}
+
+// queueRe выделяет имя устройства из имени прерывания очереди: eth0-TxRx-0, eth0-rx-1,
+// virtio0-input.0, nvme0q3.
+var queueRe = regexp.MustCompile(
+	`^(.+?)(?:-(?:(?i:txrx|rx|tx|fp|comp)|input|output|config|req|queues?)[-.]?\d*|q\d+|-\d+)$`,
+)
+
+// DeviceGroup возвращает имя устройства, которому принадлежит прерывание. Очереди одного устройства
+// закреплены за разными процессорами, поэтому баланс оценивается по сумме всех очередей.
+func DeviceGroup(device string) string {
+	if m := queueRe.FindStringSubmatch(device); m != nil {
+		return m[1]
+	}
+	return device
+}
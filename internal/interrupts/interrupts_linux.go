@@ -0,0 +1,182 @@
+//go:build linux
+
+package interrupts
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+var ErrNoInterrupts = errors.New("no interrupts statistics found")
+
+// irqCounters - накопительные счетчики прерываний по процессорам.
+type irqCounters struct {
+	irq    string
+	device string
+	perCPU []uint64
+}
+
+type snapshot struct {
+	time time.Time
+	irqs []irqCounters
+}
+
+type LinuxInterruptsCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	prev      *snapshot
+}
+
+func NewLinuxInterruptsCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxInterruptsCollector {
+	return &LinuxInterruptsCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxInterruptsCollector) Run() (<-chan Stat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("interrupts collector error", "error", err.Error())
+		l.cfg.Metrics.Interrupts = false
+		return nil, err
+	}
+	ch := make(chan Stat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorInterrupts))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("interrupts collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.Interrupts {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("interrupts collector error", "error", err.Error())
+					l.cfg.Metrics.Interrupts = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает скорости прерываний с момента предыдущего вызова, отсортированные по убыванию.
+// Прерывания, не поступавшие за интервал, не попадают в результат.
+func (l *LinuxInterruptsCollector) Get() (Stat, error) {
+	lines, err := utils.ReadLines(filepath.Join(l.cfg.System.Proc, "interrupts"))
+	if err != nil {
+		return nil, err
+	}
+	irqs, err := parseInterrupts(lines)
+	if err != nil {
+		return nil, err
+	}
+	cur := &snapshot{time: time.Now(), irqs: irqs}
+	stat := calcStat(l.prev, cur)
+	l.prev = cur
+	return stat, nil
+}
+
+// parseInterrupts разбирает /proc/interrupts. Первая строка содержит имена процессоров, далее
+// на каждой строке номер или имя прерывания, счетчики по процессорам и описание устройства.
+// Строки с единственным общим счетчиком (ERR, MIS) пропускаются.
+func parseInterrupts(lines []string) ([]irqCounters, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoInterrupts
+	}
+	cpus := len(strings.Fields(lines[0]))
+	if cpus == 0 {
+		return nil, ErrNoInterrupts
+	}
+
+	result := make([]irqCounters, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < cpus+1 {
+			continue
+		}
+		c := irqCounters{irq: strings.TrimSuffix(fields[0], ":"), perCPU: make([]uint64, cpus)}
+		valid := true
+		for i := 0; i < cpus; i++ {
+			v, err := strconv.ParseUint(fields[i+1], 10, 64)
+			if err != nil {
+				valid = false
+				break
+			}
+			c.perCPU[i] = v
+		}
+		if !valid {
+			continue
+		}
+		c.device = deviceName(c.irq, fields[cpus+1:])
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// deviceName выделяет имя устройства из описания. Для пронумерованных прерываний описание
+// начинается с контроллера и номера линии ("IO-APIC 5-edge ACPI:Ged"), их отбрасываем.
+func deviceName(irq string, desc []string) string {
+	if _, err := strconv.Atoi(irq); err == nil && len(desc) > 2 {
+		return strings.Join(desc[2:], " ")
+	}
+	return strings.Join(desc, " ")
+}
+
+func calcStat(prev, cur *snapshot) Stat {
+	result := make(Stat, 0)
+	if prev == nil {
+		return result
+	}
+	seconds := cur.time.Sub(prev.time).Seconds()
+	if seconds <= 0 {
+		return result
+	}
+	prevIRQ := make(map[string]irqCounters, len(prev.irqs))
+	for _, c := range prev.irqs {
+		prevIRQ[c.irq] = c
+	}
+
+	for _, c := range cur.irqs {
+		p, ok := prevIRQ[c.irq]
+		// Число процессоров могло измениться, такой интервал пропускаем
+		if !ok || len(p.perCPU) != len(c.perCPU) {
+			continue
+		}
+		s := IRQStat{IRQ: c.irq, Device: c.device, PerCPU: make([]float64, len(c.perCPU))}
+		for i := range c.perCPU {
+			if c.perCPU[i] >= p.perCPU[i] {
+				s.PerCPU[i] = float64(c.perCPU[i]-p.perCPU[i]) / seconds
+				s.Total += s.PerCPU[i]
+			}
+		}
+		if s.Total > 0 {
+			result = append(result, s)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Total > result[j].Total })
+	return result
+}
@@ -0,0 +1,117 @@
+//go:build linux
+
+package interrupts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+const fakeInterrupts = `           CPU0       CPU1
+  0:         46          0   IO-APIC   2-edge      timer
+ 24:        100       2000   PCI-MSI 1048576-edge      eth0-rx-0, eth0-tx-0
+ 25:          5          7   PCI-MSI 1048577-edge      nvme0q1
+NMI:          1          2   Non-maskable interrupts
+LOC:       5000       6000   Local timer interrupts
+ERR:          0
+MIS:          0`
+
+func createConfig(proc string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Proc: proc},
+		Metrics: config.Metrics{Interrupts: true},
+	}
+}
+
+func fakeProc(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "interrupts"), []byte(fakeInterrupts+"\n"), 0o600))
+	return root
+}
+
+func TestInterrupts(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("interrupts: parser", func(t *testing.T) {
+		irqs, err := parseInterrupts(strings.Split(fakeInterrupts, "\n"))
+		require.NoError(t, err)
+		require.Equal(t, []irqCounters{
+			{irq: "0", device: "timer", perCPU: []uint64{46, 0}},
+			{irq: "24", device: "eth0-rx-0, eth0-tx-0", perCPU: []uint64{100, 2000}},
+			{irq: "25", device: "nvme0q1", perCPU: []uint64{5, 7}},
+			{irq: "NMI", device: "Non-maskable interrupts", perCPU: []uint64{1, 2}},
+			{irq: "LOC", device: "Local timer interrupts", perCPU: []uint64{5000, 6000}},
+		}, irqs)
+
+		_, err = parseInterrupts(nil)
+		require.ErrorIs(t, err, ErrNoInterrupts)
+	})
+
+	t.Run("interrupts: rates", func(t *testing.T) {
+		now := time.Now()
+		prev := &snapshot{time: now, irqs: []irqCounters{
+			{irq: "24", device: "eth0", perCPU: []uint64{100, 2000}},
+			{irq: "25", device: "nvme0q1", perCPU: []uint64{5, 7}},
+			{irq: "LOC", device: "Local timer interrupts", perCPU: []uint64{5000, 6000}},
+		}}
+		cur := &snapshot{time: now.Add(2 * time.Second), irqs: []irqCounters{
+			{irq: "24", device: "eth0", perCPU: []uint64{120, 4000}},
+			{irq: "25", device: "nvme0q1", perCPU: []uint64{5, 7}},
+			{irq: "LOC", device: "Local timer interrupts", perCPU: []uint64{5400, 6600}},
+			{irq: "30", device: "new", perCPU: []uint64{1, 1}},
+		}}
+		require.Equal(t, Stat{
+			{IRQ: "24", Device: "eth0", PerCPU: []float64{10, 1000}, Total: 1010},
+			{IRQ: "LOC", Device: "Local timer interrupts", PerCPU: []float64{200, 300}, Total: 500},
+		}, calcStat(prev, cur))
+	})
+
+	t.Run("interrupts: Get()", func(t *testing.T) {
+		v := NewLinuxInterruptsCollector(context.TODO(), context.TODO(), createConfig(fakeProc(t)), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Empty(t, val)
+		// Счетчики не менялись, прерываний за интервал не было
+		val, err = v.Get()
+		require.NoError(t, err)
+		require.Empty(t, val)
+	})
+
+	t.Run("interrupts: Run() error", func(t *testing.T) {
+		cfg := createConfig(t.TempDir())
+		v := NewLinuxInterruptsCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := v.Run()
+		require.Nil(t, ch)
+		require.Error(t, err)
+		require.False(t, cfg.Metrics.Interrupts)
+	})
+
+	t.Run("interrupts: metric enabled", func(t *testing.T) {
+		cfg := createConfig(fakeProc(t))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		v := NewLinuxInterruptsCollector(ctx, ctx, cfg, log)
+
+		ch, err := v.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.NotNil(t, val)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.Interrupts)
+	})
+}
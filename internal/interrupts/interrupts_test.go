@@ -0,0 +1,45 @@
+package interrupts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBalance(t *testing.T) {
+	tests := []struct {
+		name   string
+		perCPU []float64
+		want   Balance
+	}{
+		{"no interrupts", []float64{0, 0}, Balance{}},
+		{"even", []float64{500, 500}, Balance{TopCPU: 0, TopCPUPercent: 50}},
+		{"pinned", []float64{10, 990, 0, 0}, Balance{TopCPU: 1, TopCPUPercent: 99, Imbalanced: true}},
+		{"pinned but rare", []float64{0, 50}, Balance{TopCPU: 1, TopCPUPercent: 100}},
+		{"single cpu", []float64{1000}, Balance{TopCPU: 0, TopCPUPercent: 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, CheckBalance(tt.perCPU, 80, 100))
+		})
+	}
+}
+
+func TestDeviceGroup(t *testing.T) {
+	tests := map[string]string{
+		"eth0-TxRx-0":        "eth0",
+		"eth0-TxRx-15":       "eth0",
+		"enp3s0-rx-1":        "enp3s0",
+		"enp3s0-tx-1":        "enp3s0",
+		"virtio0-input.0":    "virtio0",
+		"virtio0-config":     "virtio0",
+		"nvme0q3":            "nvme0",
+		"mlx5_comp7":         "mlx5_comp7",
+		"i8042":              "i8042",
+		"timer":              "timer",
+		"ahci[0000:00:17.0]": "ahci[0000:00:17.0]",
+	}
+	for device, want := range tests {
+		require.Equal(t, want, DeviceGroup(device), device)
+	}
+}
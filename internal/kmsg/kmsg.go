@@ -0,0 +1,126 @@
+package kmsg
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	EventOOMKill  = "oom_kill"
+	EventHungTask = "hung_task"
+	EventIOError  = "io_error"
+	EventFSError  = "fs_error"
+	EventSegfault = "segfault"
+	EventLinkUp   = "link_up"
+	EventLinkDown = "link_down"
+)
+
+// Event - классифицированное сообщение журнала ядра. Device - диск, раздел или сетевой интерфейс,
+// Process и PID - процесс, к которому относится событие. Level - уровень syslog от 0 (emerg) до 7 (debug).
+type Event struct {
+	Time    time.Time
+	Seq     uint64
+	Level   int
+	Type    string
+	Device  string
+	Process string
+	PID     int
+	Message string
+}
+
+// Batch - события, прочитанные за один опрос журнала. Reported отмечает пакет,
+// события которого уже отправлены клиенту в снимке.
+type Batch struct {
+	Events   []Event
+	Reported bool
+}
+
+func (b *Batch) String() string {
+	s, _ := json.Marshal(b.Events)
+	return string(s)
+}
+
+type Collector interface {
+	Run() (<-chan *Batch, error)
+	Get() (*Batch, error)
+}
+
+// rule - правило классификации. Из групп выражения заполняются поля события с индексами device,
+// process и pid, отрицательный индекс означает, что поле не заполняется.
+type rule struct {
+	event   string
+	re      *regexp.Regexp
+	device  int
+	process int
+	pid     int
+}
+
+var rules = []rule{
+	// Out of memory: Killed process 1234 (stress) total-vm:...
+	// Memory cgroup out of memory: Killed process 1234 (stress) ...
+	{EventOOMKill, regexp.MustCompile(`Killed process (\d+) \(([^)]*)\)`), -1, 2, 1},
+	// INFO: task kworker/0:1:123 blocked for more than 120 seconds.
+	{EventHungTask, regexp.MustCompile(`task (\S+):(\d+) blocked for more than \d+ seconds`), -1, 1, 2},
+	// blk_update_request: I/O error, dev sda, sector 2048 op 0x0:(READ) ...
+	// Buffer I/O error on dev sda1, logical block 0, async page read
+	{EventIOError, regexp.MustCompile(`I/O error,? (?:on )?dev ([^,\s]+)`), 1, -1, -1},
+	// EXT4-fs error (device sda1): ext4_find_entry:1455: inode #2: ...
+	// BTRFS critical (device sda1): corrupt leaf ...
+	{EventFSError, regexp.MustCompile(`(?:EXT[234]-fs|BTRFS) (?:error|critical) \(device ([^)]+)\)`), 1, -1, -1},
+	// XFS (sda1): Corruption detected. Unmount and run xfs_repair
+	{EventFSError, regexp.MustCompile(`XFS \(([^)]+)\): .*(?:[Cc]orrupt|[Ss]hutdown|I/O [Ee]rror)`), 1, -1, -1},
+	// stress[1234]: segfault at 0 ip 0000556 sp 00007ffd error 4 in stress[556+1000]
+	{EventSegfault, regexp.MustCompile(`(\S+)\[(\d+)\]: segfault at`), -1, 1, 2},
+	// e1000e: eth0 NIC Link is Up 1000 Mbps Full Duplex, r8169 0000:02:00.0 enp2s0: Link is Down
+	{EventLinkUp, regexp.MustCompile(`([\w.-]+):? (?:NIC )?Link is Up`), 1, -1, -1},
+	{EventLinkDown, regexp.MustCompile(`([\w.-]+):? (?:NIC )?Link is Down`), 1, -1, -1},
+}
+
+// parseRecord разбирает запись /dev/kmsg вида "6,1234,5678901,-;текст". Строки продолжения,
+// начинающиеся с пробела, и записи без заголовка пропускаются.
+func parseRecord(line string) (level int, seq uint64, message string, ok bool) {
+	header, message, found := strings.Cut(line, ";")
+	if !found {
+		return 0, 0, "", false
+	}
+	fields := strings.Split(header, ",")
+	if len(fields) < 3 {
+		return 0, 0, "", false
+	}
+	prio, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, "", false
+	}
+	seq, err = strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0, 0, "", false
+	}
+	// Младшие три бита приоритета - уровень, остальные - facility
+	return prio & 7, seq, message, true
+}
+
+// classify возвращает событие для сообщения ядра или false, если сообщение не относится
+// ни к одному из отслеживаемых типов.
+func classify(level int, seq uint64, message string) (Event, bool) {
+	for _, r := range rules {
+		m := r.re.FindStringSubmatch(message)
+		if m == nil {
+			continue
+		}
+		e := Event{Seq: seq, Level: level, Type: r.event, Message: message}
+		if r.device >= 0 {
+			e.Device = m[r.device]
+		}
+		if r.process >= 0 {
+			e.Process = m[r.process]
+		}
+		if r.pid >= 0 {
+			e.PID, _ = strconv.Atoi(m[r.pid])
+		}
+		return e, true
+	}
+	return Event{}, false
+}
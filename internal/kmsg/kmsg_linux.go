@@ -0,0 +1,155 @@
+//go:build linux
+
+package kmsg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"golang.org/x/sys/unix"
+)
+
+// Размер буфера чтения: ядро отдает /dev/kmsg по одной записи за вызов read и возвращает EINVAL,
+// если запись не помещается в буфер.
+const recordSize = 8192
+
+type LinuxKmsgCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	fd        int
+	// tail - незавершенная строка последнего чтения обычного файла
+	tail []byte
+}
+
+func NewLinuxKmsgCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxKmsgCollector {
+	return &LinuxKmsgCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+		fd:        -1,
+	}
+}
+
+func (l *LinuxKmsgCollector) Run() (<-chan *Batch, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("kernel events collector error", "error", err.Error())
+		l.cfg.Metrics.KernelEvents = false
+		l.close()
+		return nil, err
+	}
+	ch := make(chan *Batch)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorKernelEvents))
+
+	go func() {
+		defer close(ch)
+		defer l.close()
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("kernel events collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.KernelEvents {
+					continue
+				}
+				batch, err := l.Get()
+				if err != nil {
+					l.l.Error("kernel events collector error", "error", err.Error())
+					l.cfg.Metrics.KernelEvents = false
+					return
+				}
+				ch <- batch
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает события, появившиеся в журнале с момента предыдущего вызова. Первый вызов
+// открывает журнал и переходит в его конец, поэтому накопленные до подключения клиента сообщения
+// не передаются. Время события - момент чтения записи.
+func (l *LinuxKmsgCollector) Get() (*Batch, error) {
+	if l.fd < 0 {
+		return &Batch{}, l.open()
+	}
+	lines, err := l.read()
+	if err != nil {
+		return nil, err
+	}
+	batch := &Batch{}
+	now := time.Now()
+	for _, line := range lines {
+		level, seq, message, ok := parseRecord(line)
+		if !ok {
+			continue
+		}
+		if e, ok := classify(level, seq, message); ok {
+			e.Time = now
+			batch.Events = append(batch.Events, e)
+		}
+	}
+	return batch, nil
+}
+
+func (l *LinuxKmsgCollector) open() error {
+	// Чтение без блокировки: при отсутствии новых записей /dev/kmsg возвращает EAGAIN
+	fd, err := unix.Open(l.cfg.System.Kmsg, unix.O_RDONLY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	if _, err = unix.Seek(fd, 0, io.SeekEnd); err != nil {
+		unix.Close(fd)
+		return err
+	}
+	l.fd = fd
+	return nil
+}
+
+func (l *LinuxKmsgCollector) close() {
+	if l.fd >= 0 {
+		unix.Close(l.fd)
+		l.fd = -1
+	}
+}
+
+// read читает все доступные записи. /dev/kmsg возвращает по записи за вызов, обычный файл - произвольные
+// куски, поэтому незавершенная последняя строка сохраняется до следующего чтения.
+func (l *LinuxKmsgCollector) read() ([]string, error) {
+	var lines []string
+	buf := make([]byte, recordSize)
+	for {
+		n, err := unix.Read(l.fd, buf)
+		switch {
+		case errors.Is(err, unix.EAGAIN):
+			return lines, nil
+		// Записи были перезаписаны до чтения, следующий вызов вернет самую старую из оставшихся
+		case errors.Is(err, unix.EPIPE), errors.Is(err, unix.EINTR):
+			continue
+		case err != nil:
+			return nil, err
+		case n == 0:
+			return lines, nil
+		}
+		data := append(l.tail, buf[:n]...)
+		for {
+			i := bytes.IndexByte(data, '\n')
+			if i < 0 {
+				break
+			}
+			lines = append(lines, string(data[:i]))
+			data = data[i+1:]
+		}
+		l.tail = append([]byte(nil), data...)
+	}
+}
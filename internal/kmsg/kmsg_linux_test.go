@@ -0,0 +1,109 @@
+//go:build linux
+
+package kmsg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+func createConfig(kmsg string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Kmsg: kmsg},
+		Metrics: config.Metrics{KernelEvents: true},
+	}
+}
+
+// fakeKmsg создает журнал с сообщением, записанным до подключения сборщика.
+func fakeKmsg(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "kmsg")
+	require.NoError(t, os.WriteFile(path, []byte("2,1,100,-;Out of memory: Killed process 1 (old) total-vm:1kB\n"), 0o600))
+	return path
+}
+
+func appendKmsg(t *testing.T, path, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestKmsg(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("kmsg: Get()", func(t *testing.T) {
+		path := fakeKmsg(t)
+		l := NewLinuxKmsgCollector(context.TODO(), context.TODO(), createConfig(path), log)
+		defer l.close()
+
+		// Сообщения, записанные до подключения, пропускаются
+		batch, err := l.Get()
+		require.NoError(t, err)
+		require.Empty(t, batch.Events)
+
+		appendKmsg(t, path, "6,2,200,-;usb 1-1: new device\n"+
+			"3,3,300,-;blk_update_request: I/O error, dev sdb, sector 2048\n"+
+			" SUBSYSTEM=block\n"+
+			"6,4,400,-;e1000e: eth0 NIC Link is Do")
+		batch, err = l.Get()
+		require.NoError(t, err)
+		require.Len(t, batch.Events, 1)
+		require.Equal(t, EventIOError, batch.Events[0].Type)
+		require.Equal(t, uint64(3), batch.Events[0].Seq)
+		require.False(t, batch.Events[0].Time.IsZero())
+
+		// Незавершенная запись дописана
+		appendKmsg(t, path, "wn\n")
+		batch, err = l.Get()
+		require.NoError(t, err)
+		require.Len(t, batch.Events, 1)
+		require.Equal(t, EventLinkDown, batch.Events[0].Type)
+		require.Equal(t, "eth0", batch.Events[0].Device)
+
+		batch, err = l.Get()
+		require.NoError(t, err)
+		require.Empty(t, batch.Events)
+	})
+
+	t.Run("kmsg: Run() error", func(t *testing.T) {
+		cfg := createConfig(filepath.Join(t.TempDir(), "missing"))
+		l := NewLinuxKmsgCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := l.Run()
+		require.Nil(t, ch)
+		require.Error(t, err)
+		require.False(t, cfg.Metrics.KernelEvents)
+	})
+
+	t.Run("kmsg: metric enabled", func(t *testing.T) {
+		path := fakeKmsg(t)
+		cfg := createConfig(path)
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		l := NewLinuxKmsgCollector(ctx, ctx, cfg, log)
+
+		ch, err := l.Run()
+		require.NoError(t, err)
+		appendKmsg(t, path, "4,2,200,-;stress[5555]: segfault at 0 ip 0 sp 0 error 4 in stress[1+1000]\n")
+		val := <-ch
+		require.Len(t, val.Events, 1)
+		require.Equal(t, EventSegfault, val.Events[0].Type)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.KernelEvents)
+	})
+}
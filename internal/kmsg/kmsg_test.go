@@ -0,0 +1,94 @@
+package kmsg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecord(t *testing.T) {
+	level, seq, message, ok := parseRecord("3,1024,5678901,-;EXT4-fs error (device sda1): ext4_find_entry:1455")
+	require.True(t, ok)
+	require.Equal(t, 3, level)
+	require.Equal(t, uint64(1024), seq)
+	require.Equal(t, "EXT4-fs error (device sda1): ext4_find_entry:1455", message)
+
+	// Приоритет записи из пространства пользователя включает facility
+	level, _, _, ok = parseRecord("14,1025,5678902,-;test message")
+	require.True(t, ok)
+	require.Equal(t, 6, level)
+
+	_, _, _, ok = parseRecord(" SUBSYSTEM=block")
+	require.False(t, ok)
+	_, _, _, ok = parseRecord("x,1,2,-;message")
+	require.False(t, ok)
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		message string
+		event   Event
+	}{
+		{
+			"Out of memory: Killed process 4321 (stress) total-vm:1048576kB, anon-rss:524288kB",
+			Event{Type: EventOOMKill, Process: "stress", PID: 4321},
+		},
+		{
+			"Memory cgroup out of memory: Killed process 77 (java) total-vm:10kB",
+			Event{Type: EventOOMKill, Process: "java", PID: 77},
+		},
+		{
+			"INFO: task kworker/0:1:123 blocked for more than 120 seconds.",
+			Event{Type: EventHungTask, Process: "kworker/0:1", PID: 123},
+		},
+		{
+			"blk_update_request: I/O error, dev sdb, sector 2048 op 0x0:(READ) flags 0x0 phys_seg 1 prio class 0",
+			Event{Type: EventIOError, Device: "sdb"},
+		},
+		{
+			"Buffer I/O error on dev sdb1, logical block 0, async page read",
+			Event{Type: EventIOError, Device: "sdb1"},
+		},
+		{
+			"EXT4-fs error (device sda1): ext4_find_entry:1455: inode #2: comm ls: reading directory lblock 0",
+			Event{Type: EventFSError, Device: "sda1"},
+		},
+		{
+			"BTRFS critical (device dm-0): corrupt leaf: root=5 block=30408704 slot=7",
+			Event{Type: EventFSError, Device: "dm-0"},
+		},
+		{
+			"XFS (nvme0n1p2): Corruption detected. Unmount and run xfs_repair",
+			Event{Type: EventFSError, Device: "nvme0n1p2"},
+		},
+		{
+			"stress[5555]: segfault at 0 ip 000055d0c8a0 sp 00007ffd1d0 error 4 in stress[55d0c8a+1000]",
+			Event{Type: EventSegfault, Process: "stress", PID: 5555},
+		},
+		{
+			"e1000e: eth0 NIC Link is Up 1000 Mbps Full Duplex, Flow Control: None",
+			Event{Type: EventLinkUp, Device: "eth0"},
+		},
+		{
+			"r8169 0000:02:00.0 enp2s0: Link is Down",
+			Event{Type: EventLinkDown, Device: "enp2s0"},
+		},
+	}
+	for _, tt := range tests {
+		e, ok := classify(3, 10, tt.message)
+		require.True(t, ok, tt.message)
+		tt.event.Level = 3
+		tt.event.Seq = 10
+		tt.event.Message = tt.message
+		require.Equal(t, tt.event, e)
+	}
+
+	for _, message := range []string{
+		"XFS (sda1): Mounting V5 Filesystem",
+		"EXT4-fs (sda1): mounted filesystem with ordered data mode",
+		"usb 1-1: new high-speed USB device number 2 using xhci_hcd",
+	} {
+		_, ok := classify(6, 1, message)
+		require.False(t, ok, message)
+	}
+}
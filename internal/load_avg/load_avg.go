@@ -8,6 +8,10 @@ type AvgStat struct {
 	Load1  float64 `json:"load1"`
 	Load5  float64 `json:"load5"`
 	Load15 float64 `json:"load15"`
+	// Running - количество исполняемых задач, Total - общее количество потоков в системе
+	Running uint64 `json:"running"`
+	Total   uint64 `json:"total"`
+	LastPID uint64 `json:"lastPid"`
 }
 
 func (l AvgStat) String() string {
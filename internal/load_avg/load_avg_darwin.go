@@ -39,7 +39,7 @@ func (l *DarwinLoadAverageCollector) Run() (<-chan *AvgStat, error) {
 		return nil, err
 	}
 	ch := make(chan *AvgStat)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorLoadAvg))
 
 	go func() {
 		defer close(ch)
@@ -4,6 +4,7 @@ package loadavg
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -39,7 +40,7 @@ func (l *LinuxLoadAverageCollector) Run() (<-chan *AvgStat, error) {
 		return nil, err
 	}
 	ch := make(chan *AvgStat)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorLoadAvg))
 
 	go func() {
 		defer close(ch)
@@ -91,6 +92,23 @@ func (l *LinuxLoadAverageCollector) Get() (*AvgStat, error) {
 		Load15: load15,
 	}
 
+	// Формат: "0.20 0.18 0.12 1/80 11206", где 1/80 - исполняемые задачи и всего потоков
+	if len(values) >= 5 {
+		running, total, ok := strings.Cut(values[3], "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid tasks field in loadavg: %s", values[3])
+		}
+		if ret.Running, err = strconv.ParseUint(running, 10, 64); err != nil {
+			return nil, err
+		}
+		if ret.Total, err = strconv.ParseUint(total, 10, 64); err != nil {
+			return nil, err
+		}
+		if ret.LastPID, err = strconv.ParseUint(values[4], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
 	return ret, nil
 }
 
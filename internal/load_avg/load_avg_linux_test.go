@@ -42,6 +42,9 @@ func TestLoadAvgStat(t *testing.T) {
 		require.Equal(t, 2.12, val.Load1)
 		require.Equal(t, 2.30, val.Load5)
 		require.Equal(t, 2.37, val.Load15)
+		require.Equal(t, uint64(3), val.Running)
+		require.Equal(t, uint64(2420), val.Total)
+		require.Equal(t, uint64(137390), val.LastPID)
 	})
 
 	t.Run("load avg: Get() error", func(t *testing.T) {
@@ -60,6 +63,21 @@ func TestLoadAvgStat(t *testing.T) {
 		require.Nil(t, val)
 	})
 
+	t.Run("load avg: Get() invalid tasks", func(t *testing.T) {
+		cfg := createConfig()
+		v := NewLinuxLoadAverageCollector(context.TODO(), context.TODO(), cfg, log)
+		patches := gomonkey.NewPatches()
+		patches.ApplyPrivateMethod(
+			&LinuxLoadAverageCollector{}, "readLoadAvgFromFile", func() ([]string, error) {
+				return []string{"2.12", "2.30", "2.37", "2420", "137390"}, nil
+			})
+		t.Cleanup(func() { patches.Reset() })
+
+		val, err := v.Get()
+		require.Error(t, err)
+		require.Nil(t, val)
+	})
+
 	t.Run("load avg: Run() error", func(t *testing.T) {
 		cfg := createConfig()
 		v := NewLinuxLoadAverageCollector(context.TODO(), context.TODO(), cfg, log)
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// aggregations - функции агрегации по имени. values - значения в порядке измерений,
+// sorted - те же значения по возрастанию.
+var aggregations = map[string]struct {
+	calc  func(values, sorted []float64) float64
+	field func(a *pb.Aggregates) **float64
+}{
+	"mean": {
+		calc:  func(v, _ []float64) float64 { return mean(v) },
+		field: func(a *pb.Aggregates) **float64 { return &a.Mean },
+	},
+	"min": {
+		calc:  func(_, s []float64) float64 { return s[0] },
+		field: func(a *pb.Aggregates) **float64 { return &a.Min },
+	},
+	"max": {
+		calc:  func(_, s []float64) float64 { return s[len(s)-1] },
+		field: func(a *pb.Aggregates) **float64 { return &a.Max },
+	},
+	"last": {
+		calc:  func(v, _ []float64) float64 { return v[len(v)-1] },
+		field: func(a *pb.Aggregates) **float64 { return &a.Last },
+	},
+	"p50": {
+		calc:  func(_, s []float64) float64 { return percentile(s, 50) },
+		field: func(a *pb.Aggregates) **float64 { return &a.P50 },
+	},
+	"p95": {
+		calc:  func(_, s []float64) float64 { return percentile(s, 95) },
+		field: func(a *pb.Aggregates) **float64 { return &a.P95 },
+	},
+	"p99": {
+		calc:  func(_, s []float64) float64 { return percentile(s, 99) },
+		field: func(a *pb.Aggregates) **float64 { return &a.P99 },
+	},
+	"stddev": {
+		calc:  func(v, _ []float64) float64 { return stddev(v) },
+		field: func(a *pb.Aggregates) **float64 { return &a.Stddev },
+	},
+}
+
+// Aggregate считает по значениям окна статистики из списка names: mean, min, max, last,
+// p50, p95, p99 и stddev. Значения передаются в порядке измерений. Если функции не запрошены
+// или значений нет, возвращается nil.
+func Aggregate(values []float64, names []string) *pb.Aggregates {
+	if len(names) == 0 || len(values) == 0 {
+		return nil
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	result := &pb.Aggregates{}
+	for _, name := range names {
+		if f, ok := aggregations[name]; ok {
+			v := f.calc(values, sorted)
+			*f.field(result) = &v
+		}
+	}
+	return result
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// stddev возвращает стандартное отклонение генеральной совокупности: окно содержит все измерения
+// за период, а не выборку из них.
+func stddev(values []float64) float64 {
+	m := mean(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)))
+}
+
+// percentile возвращает перцентиль p отсортированных значений с линейной интерполяцией
+// между соседними рангами.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregate(t *testing.T) {
+	require.Nil(t, Aggregate([]float64{1, 2}, nil))
+	require.Nil(t, Aggregate(nil, []string{"mean"}))
+
+	values := []float64{4, 1, 3, 2, 10}
+	a := Aggregate(values, []string{"mean", "min", "max", "last", "p50", "p95", "p99", "stddev"})
+	require.InDelta(t, 4.0, a.GetMean(), 1e-9)
+	require.InDelta(t, 1.0, a.GetMin(), 1e-9)
+	require.InDelta(t, 10.0, a.GetMax(), 1e-9)
+	require.InDelta(t, 10.0, a.GetLast(), 1e-9)
+	require.InDelta(t, 3.0, a.GetP50(), 1e-9)
+	// Ранг 3.8 между 4 и 10
+	require.InDelta(t, 8.8, a.GetP95(), 1e-9)
+	require.InDelta(t, 9.76, a.GetP99(), 1e-9)
+	require.InDelta(t, 3.16227766, a.GetStddev(), 1e-6)
+	// Исходный порядок значений не меняется
+	require.Equal(t, []float64{4, 1, 3, 2, 10}, values)
+
+	// Незапрошенные функции не заполняются
+	a = Aggregate([]float64{5}, []string{"max", "unknown"})
+	require.NotNil(t, a.Max)
+	require.Nil(t, a.Mean)
+	require.Nil(t, a.P95)
+	require.InDelta(t, 5.0, a.GetMax(), 1e-9)
+}
@@ -0,0 +1,63 @@
+package conntrack
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorConntrack
+
+func init() {
+	metrics.Register(metrics.Metric[*network.ConntrackStat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.Conntrack },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.Conntrack = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Conntrack = cfg.Metrics.Conntrack
+		},
+		Apply: func(env *metrics.Env, data []*network.ConntrackStat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Conntrack = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate усредняет скорости счетчиков, заполнение таблицы и разбивка записей
+// берутся из последнего измерения.
+func calculate(cfg *config.DaemonConfig, data []*network.ConntrackStat) *pb.Conntrack {
+	if !cfg.Metrics.Conntrack {
+		return nil
+	}
+	if len(data) == 0 {
+		return &pb.Conntrack{}
+	}
+
+	last := data[len(data)-1]
+	result := &pb.Conntrack{
+		Count:       last.Count,
+		Max:         last.Max,
+		FillPercent: last.FillPercent,
+	}
+	for _, stat := range data {
+		result.InsertFailed += stat.InsertFailed
+		result.Drop += stat.Drop
+		result.EarlyDrop += stat.EarlyDrop
+		result.Invalid += stat.Invalid
+	}
+	n := float64(len(data))
+	result.InsertFailed /= n
+	result.Drop /= n
+	result.EarlyDrop /= n
+	result.Invalid /= n
+
+	for _, e := range last.Entries {
+		result.Entries = append(result.Entries, &pb.ConntrackEntries{
+			Protocol: e.Protocol,
+			State:    e.State,
+			Count:    e.Count,
+		})
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package conntrack
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(_ *metrics.Env) (<-chan *network.ConntrackStat, error) {
+	return nil, metrics.ErrUnsupported
+}
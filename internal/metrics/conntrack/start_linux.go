@@ -0,0 +1,12 @@
+//go:build linux
+
+package conntrack
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(env *metrics.Env) (<-chan *network.ConntrackStat, error) {
+	return network.NewLinuxConntrackCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,86 @@
+package cpuavg
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/cpu"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorCPUAvg
+
+func init() {
+	metrics.Register(metrics.Metric[*cpu.Data]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.CPUAvg },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.CPUAvg = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.CpuAvg = cfg.Metrics.CPUAvg
+		},
+		Apply: func(env *metrics.Env, data []*cpu.Data, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.CpuAvg = calculate(env.Cfg, env.Aggregations, data)
+		},
+	})
+}
+
+// calculate усредняет загрузку процессора и активность ядра за окно. Функции агрегации
+// считаются для долей user, system и idle.
+func calculate(cfg *config.DaemonConfig, aggregations []string, data []*cpu.Data) *pb.CpuAverage {
+	if !cfg.Metrics.CPUAvg {
+		return nil
+	}
+	if len(data) == 0 {
+		return &pb.CpuAverage{}
+	}
+	result := &pb.CpuAverage{
+		User:   0,
+		Idle:   0,
+		System: 0,
+	}
+
+	user := make([]float64, 0, len(data))
+	system := make([]float64, 0, len(data))
+	idle := make([]float64, 0, len(data))
+	softirqs := make(map[string]*pb.Softirq)
+	for _, stat := range data {
+		result.Idle += stat.Idle
+		result.System += stat.System
+		result.User += stat.User
+		user = append(user, stat.User)
+		system = append(system, stat.System)
+		idle = append(idle, stat.Idle)
+		result.ContextSwitches += stat.ContextSwitches
+		result.Interrupts += stat.Interrupts
+		result.Forks += stat.Forks
+		result.ProcsRunning += float64(stat.ProcsRunning)
+		result.ProcsBlocked += float64(stat.ProcsBlocked)
+		for _, v := range stat.Softirqs {
+			item, ok := softirqs[v.Name]
+			if !ok {
+				item = &pb.Softirq{Name: v.Name}
+				softirqs[v.Name] = item
+				result.Softirqs = append(result.Softirqs, item)
+			}
+			item.Rate += v.Rate
+		}
+	}
+
+	n := float64(len(data))
+	result.User /= n
+	result.Idle /= n
+	result.System /= n
+	result.ContextSwitches /= n
+	result.Interrupts /= n
+	result.Forks /= n
+	result.ProcsRunning /= n
+	result.ProcsBlocked /= n
+	for _, item := range result.Softirqs {
+		item.Rate /= n
+	}
+	result.UserStats = metrics.Aggregate(user, aggregations)
+	result.SystemStats = metrics.Aggregate(system, aggregations)
+	result.IdleStats = metrics.Aggregate(idle, aggregations)
+
+	return result
+}
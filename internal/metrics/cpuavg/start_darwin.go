@@ -0,0 +1,12 @@
+//go:build darwin
+
+package cpuavg
+
+import (
+	"github.com/skushnerchuk/simda/internal/cpu"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(_ *metrics.Env) (<-chan *cpu.Data, error) {
+	return nil, metrics.ErrUnsupported
+}
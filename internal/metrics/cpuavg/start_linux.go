@@ -0,0 +1,13 @@
+//go:build linux
+
+package cpuavg
+
+import (
+	"github.com/skushnerchuk/simda/internal/cpu"
+	"github.com/skushnerchuk/simda/internal/cpu/cpulinux"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan *cpu.Data, error) {
+	return cpulinux.NewLinuxCPUCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
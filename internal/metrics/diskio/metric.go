@@ -0,0 +1,73 @@
+package diskio
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorDiskIO
+
+func init() {
+	metrics.Register(metrics.Metric[disk.IOStatMap]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.DiskIO },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.DiskIO = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.DiskIO = cfg.Metrics.DiskIO
+		},
+		Apply: func(env *metrics.Env, data []disk.IOStatMap, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.DiskIO = calculate(env.Cfg, env.Aggregations, data)
+		},
+	})
+}
+
+// calculate усредняет скорости устройств за окно и считает по ним запрошенные функции агрегации.
+// Статистики устройства считаются по измерениям, в которых оно присутствовало.
+func calculate(cfg *config.DaemonConfig, aggregations []string, data []disk.IOStatMap) []*pb.DiskIO {
+	if !cfg.Metrics.DiskIO || len(data) == 0 {
+		return nil
+	}
+
+	type series struct {
+		stat        disk.IOStat
+		tps, rd, wr []float64
+	}
+	devices := make(map[string]*series)
+	for _, item := range data {
+		for k, v := range item {
+			s, ok := devices[k]
+			if !ok {
+				s = &series{stat: disk.IOStat{Name: v.Name, Label: v.Label, Kind: v.Kind, Disks: v.Disks}}
+				devices[k] = s
+			}
+			s.stat.Tps += v.Tps
+			s.stat.RdSpeed += v.RdSpeed
+			s.stat.WrSpeed += v.WrSpeed
+			s.tps = append(s.tps, v.Tps)
+			s.rd = append(s.rd, v.RdSpeed)
+			s.wr = append(s.wr, v.WrSpeed)
+		}
+	}
+
+	result := make([]*pb.DiskIO, 0, len(devices))
+	n := float64(len(data))
+	for _, s := range devices {
+		result = append(result, &pb.DiskIO{
+			Name:         s.stat.Name,
+			Tps:          s.stat.Tps / n,
+			RdSpeed:      s.stat.RdSpeed / n,
+			WrSpeed:      s.stat.WrSpeed / n,
+			Label:        s.stat.Label,
+			Kind:         s.stat.Kind,
+			Disks:        s.stat.Disks,
+			TpsStats:     metrics.Aggregate(s.tps, aggregations),
+			RdSpeedStats: metrics.Aggregate(s.rd, aggregations),
+			WrSpeedStats: metrics.Aggregate(s.wr, aggregations),
+		})
+	}
+
+	return result
+}
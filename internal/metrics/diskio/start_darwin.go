@@ -0,0 +1,12 @@
+//go:build darwin
+
+package diskio
+
+import (
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(_ *metrics.Env) (<-chan disk.IOStatMap, error) {
+	return nil, metrics.ErrUnsupported
+}
@@ -0,0 +1,13 @@
+//go:build linux
+
+package diskio
+
+import (
+	"github.com/skushnerchuk/simda/internal/disk"
+	collector "github.com/skushnerchuk/simda/internal/disk/diskio"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan disk.IOStatMap, error) {
+	return collector.NewLinuxDiskIOCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,132 @@
+package diskusage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/disk/forecast"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorDiskUsage
+
+func init() {
+	metrics.Register(metrics.Metric[disk.UsageStatMap]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.DiskUsage },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.DiskUsage = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.DiskUsage = cfg.Metrics.DiskUsage
+		},
+		Apply: func(env *metrics.Env, data []disk.UsageStatMap, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.DiskUsage = calculate(env, data)
+		},
+	})
+}
+
+func calculate(env *metrics.Env, data []disk.UsageStatMap) []*pb.DiskUsage {
+	if !env.Cfg.Metrics.DiskUsage {
+		return nil
+	}
+	avgData := make(disk.UsageStatMap)
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	for k, v := range data[0] {
+		avgData[k] = &disk.UsageStat{Mountpoint: v.Mountpoint, Device: v.Device, Type: v.Type, ReadOnly: v.ReadOnly}
+	}
+
+	for _, item := range data {
+		for k, v := range item {
+			avgData[k].UsagePercent += v.UsagePercent
+			avgData[k].Usage += v.Usage
+			avgData[k].INodeAvailablePercent += v.INodeAvailablePercent
+			avgData[k].INodeCount += v.INodeCount
+			avgData[k].Total += v.Total
+			avgData[k].Used += v.Used
+			avgData[k].Free += v.Free
+			avgData[k].INodeUsed += v.INodeUsed
+			avgData[k].INodeFree += v.INodeFree
+			// Признак устаревания берется из последнего измерения
+			avgData[k].Stale = v.Stale
+		}
+	}
+
+	for k, v := range avgData {
+		avgData[k].UsagePercent = v.UsagePercent / float64(len(data))
+		avgData[k].Usage = v.Usage / float64(len(data))
+		avgData[k].INodeAvailablePercent = v.INodeAvailablePercent / float64(len(data))
+		avgData[k].INodeCount = v.INodeCount / float64(len(data))
+		n := uint64(len(data))
+		avgData[k].Total = v.Total / n
+		avgData[k].Used = v.Used / n
+		avgData[k].Free = v.Free / n
+		avgData[k].INodeUsed = v.INodeUsed / n
+		avgData[k].INodeFree = v.INodeFree / n
+	}
+
+	result := make([]*pb.DiskUsage, 0)
+
+	for _, v := range avgData {
+		forecasts, timeToFull := diskForecast(env, v.Mountpoint)
+		result = append(result, &pb.DiskUsage{
+			Device:                v.Device,
+			MountPoint:            v.Mountpoint,
+			UsagePercent:          v.UsagePercent,
+			Usage:                 v.Usage,
+			InodeAvailablePercent: v.INodeAvailablePercent,
+			InodeCount:            v.INodeCount,
+			FsType:                v.Type,
+			ReadOnly:              v.ReadOnly,
+			Total:                 v.Total,
+			Used:                  v.Used,
+			Free:                  v.Free,
+			InodesUsed:            v.INodeUsed,
+			InodesFree:            v.INodeFree,
+			Stale:                 v.Stale,
+			Forecasts:             forecasts,
+			TimeToFullSec:         timeToFull.Seconds(),
+			FillingSoon:           timeToFull > 0 && timeToFull <= env.Cfg.Forecast.Threshold,
+		})
+	}
+
+	return result
+}
+
+func diskForecast(env *metrics.Env, mountpoint string) ([]*pb.DiskForecast, time.Duration) {
+	if env.History == nil {
+		return nil, 0
+	}
+	now := time.Now()
+	estimates := make([]forecast.Estimate, 0, len(env.Cfg.Forecast.Windows))
+	result := make([]*pb.DiskForecast, 0, len(env.Cfg.Forecast.Windows))
+	for _, w := range env.Cfg.Forecast.Windows {
+		e := env.History.Forecast(mountpoint, w, now)
+		estimates = append(estimates, e)
+		result = append(result, &pb.DiskForecast{
+			Window:        windowName(w),
+			BytesPerSec:   e.BytesPerSec,
+			InodesPerSec:  e.INodesPerSec,
+			TimeToFullSec: e.TimeToFull.Seconds(),
+		})
+	}
+	return result, forecast.Soonest(estimates)
+}
+
+// windowName возвращает короткую запись окна прогноза: 1h вместо 1h0m0s.
+func windowName(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package diskusage
+
+import (
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(_ *metrics.Env) (<-chan disk.UsageStatMap, error) {
+	return nil, metrics.ErrUnsupported
+}
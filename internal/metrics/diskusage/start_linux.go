@@ -0,0 +1,18 @@
+//go:build linux
+
+package diskusage
+
+import (
+	"github.com/skushnerchuk/simda/internal/disk"
+	collector "github.com/skushnerchuk/simda/internal/disk/diskusage"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+// start читает измерения общего сборщика демона, если он запущен, иначе опрашивает точки
+// монтирования самостоятельно.
+func start(env *metrics.Env) (<-chan disk.UsageStatMap, error) {
+	if env.DiskUsage != nil {
+		return collector.Follow(env.ServerCtx, env.ClientCtx, env.Cfg, env.DiskUsage, env.Log), nil
+	}
+	return collector.NewLinuxDiskUsageCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
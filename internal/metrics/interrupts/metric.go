@@ -0,0 +1,113 @@
+package interrupts
+
+import (
+	"sort"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	collector "github.com/skushnerchuk/simda/internal/interrupts"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorInterrupts
+
+func init() {
+	metrics.Register(metrics.Metric[collector.Stat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.Interrupts },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.Interrupts = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Interrupts = cfg.Metrics.Interrupts
+		},
+		Apply: func(env *metrics.Env, data []collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Interrupts, snapshot.InterruptDevices = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate усредняет скорости прерываний по буферу. Прерывание, не поступавшее
+// в части измерений, считается нулевым в них. Дисбаланс оценивается по усредненным значениям,
+// просуммированным по всем прерываниям устройства.
+func calculate(cfg *config.DaemonConfig, data []collector.Stat) ([]*pb.Interrupt, []*pb.InterruptDevice) {
+	if !cfg.Metrics.Interrupts {
+		return nil, nil
+	}
+
+	avgData := make(map[string]*pb.Interrupt)
+	result := make([]*pb.Interrupt, 0)
+	for _, item := range data {
+		for _, v := range item {
+			a, ok := avgData[v.IRQ]
+			if !ok {
+				a = &pb.Interrupt{Irq: v.IRQ, Device: v.Device}
+				avgData[v.IRQ] = a
+				result = append(result, a)
+			}
+			// Число процессоров могло измениться между измерениями
+			if len(a.PerCpu) < len(v.PerCPU) {
+				a.PerCpu = append(a.PerCpu, make([]float64, len(v.PerCPU)-len(a.PerCpu))...)
+			}
+			for i, rate := range v.PerCPU {
+				a.PerCpu[i] += rate
+			}
+		}
+	}
+
+	n := float64(len(data))
+	for _, a := range result {
+		a.Total = 0
+		for i := range a.PerCpu {
+			a.PerCpu[i] /= n
+			a.Total += a.PerCpu[i]
+		}
+		b := collector.CheckBalance(a.PerCpu, cfg.Interrupts.ImbalancePercent, cfg.Interrupts.MinRate)
+		a.TopCpu = uint32(b.TopCPU)
+		a.TopCpuPercent = b.TopCPUPercent
+	}
+	devices := groupDevices(cfg, result)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Total > result[j].Total })
+	return result, devices
+}
+
+// groupDevices суммирует прерывания по устройствам и оценивает баланс каждого устройства. Прерывания
+// устройства с дисбалансом помечаются. Линии без имени устройства оцениваются отдельно.
+func groupDevices(cfg *config.DaemonConfig, interrupts []*pb.Interrupt) []*pb.InterruptDevice {
+	byName := make(map[string]*pb.InterruptDevice)
+	members := make(map[*pb.InterruptDevice][]*pb.Interrupt)
+	result := make([]*pb.InterruptDevice, 0)
+	for _, a := range interrupts {
+		name := collector.DeviceGroup(a.Device)
+		key := name
+		if name == "" {
+			key = "irq:" + a.Irq
+		}
+		d, ok := byName[key]
+		if !ok {
+			d = &pb.InterruptDevice{Device: name}
+			byName[key] = d
+			result = append(result, d)
+		}
+		d.Irqs = append(d.Irqs, a.Irq)
+		if len(d.PerCpu) < len(a.PerCpu) {
+			d.PerCpu = append(d.PerCpu, make([]float64, len(a.PerCpu)-len(d.PerCpu))...)
+		}
+		for i, rate := range a.PerCpu {
+			d.PerCpu[i] += rate
+		}
+		d.Total += a.Total
+		members[d] = append(members[d], a)
+	}
+
+	for _, d := range result {
+		b := collector.CheckBalance(d.PerCpu, cfg.Interrupts.ImbalancePercent, cfg.Interrupts.MinRate)
+		d.TopCpu = uint32(b.TopCPU)
+		d.TopCpuPercent = b.TopCPUPercent
+		d.Imbalanced = b.Imbalanced
+		for _, a := range members[d] {
+			a.Imbalanced = b.Imbalanced
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Total > result[j].Total })
+	return result
+}
@@ -0,0 +1,46 @@
+package interrupts
+
+import (
+	"testing"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	collector "github.com/skushnerchuk/simda/internal/interrupts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateMultiQueueDevice(t *testing.T) {
+	cfg := &config.DaemonConfig{}
+	cfg.Metrics.Interrupts = true
+	cfg.Interrupts = config.Interrupts{ImbalancePercent: 80, MinRate: 100}
+
+	data := []collector.Stat{{
+		// Каждая очередь сетевой карты закреплена за своим процессором
+		{IRQ: "40", Device: "eth0-TxRx-0", PerCPU: []float64{500, 0, 0, 0}},
+		{IRQ: "41", Device: "eth0-TxRx-1", PerCPU: []float64{0, 500, 0, 0}},
+		{IRQ: "42", Device: "eth0-TxRx-2", PerCPU: []float64{0, 0, 500, 0}},
+		{IRQ: "43", Device: "eth0-TxRx-3", PerCPU: []float64{0, 0, 0, 500}},
+		// Все очереди диска обрабатывает один процессор
+		{IRQ: "50", Device: "nvme0q1", PerCPU: []float64{0, 300, 0, 0}},
+		{IRQ: "51", Device: "nvme0q2", PerCPU: []float64{0, 300, 0, 0}},
+		{IRQ: "52", Device: "nvme0q3", PerCPU: []float64{10, 300, 0, 0}},
+	}}
+	interrupts, devices := calculate(cfg, data)
+	require.Len(t, interrupts, 7)
+	require.Len(t, devices, 2)
+
+	eth := devices[0]
+	require.Equal(t, "eth0", eth.Device)
+	require.Equal(t, []string{"40", "41", "42", "43"}, eth.Irqs)
+	require.Equal(t, []float64{500, 500, 500, 500}, eth.PerCpu)
+	require.Equal(t, 2000.0, eth.Total)
+	require.False(t, eth.Imbalanced)
+
+	nvme := devices[1]
+	require.Equal(t, "nvme0", nvme.Device)
+	require.Equal(t, uint32(1), nvme.TopCpu)
+	require.True(t, nvme.Imbalanced)
+
+	for _, a := range interrupts {
+		require.Equal(t, collector.DeviceGroup(a.Device) == "nvme0", a.Imbalanced, a.Device)
+	}
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package interrupts
+
+import (
+	collector "github.com/skushnerchuk/simda/internal/interrupts"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(_ *metrics.Env) (<-chan collector.Stat, error) {
+	return nil, metrics.ErrUnsupported
+}
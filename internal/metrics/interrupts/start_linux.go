@@ -0,0 +1,12 @@
+//go:build linux
+
+package interrupts
+
+import (
+	collector "github.com/skushnerchuk/simda/internal/interrupts"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan collector.Stat, error) {
+	return collector.NewLinuxInterruptsCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
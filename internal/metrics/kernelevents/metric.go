@@ -0,0 +1,53 @@
+package kernelevents
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/kmsg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorKernelEvents
+
+func init() {
+	metrics.Register(metrics.Metric[*kmsg.Batch]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.KernelEvents },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.KernelEvents = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.KernelEvents = cfg.Metrics.KernelEvents
+		},
+		Apply: func(env *metrics.Env, data []*kmsg.Batch, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.KernelEvents = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate возвращает события, которые еще не попадали в снимки. Окна соседних снимков
+// перекрываются, поэтому отправленные пакеты отмечаются и повторно не передаются.
+func calculate(cfg *config.DaemonConfig, data []*kmsg.Batch) []*pb.KernelEvent {
+	if !cfg.Metrics.KernelEvents {
+		return nil
+	}
+	result := make([]*pb.KernelEvent, 0)
+	for _, batch := range data {
+		if batch.Reported {
+			continue
+		}
+		batch.Reported = true
+		for _, e := range batch.Events {
+			result = append(result, &pb.KernelEvent{
+				Time:    e.Time.UnixMilli(),
+				Seq:     e.Seq,
+				Level:   int32(e.Level),
+				Type:    e.Type,
+				Device:  e.Device,
+				Process: e.Process,
+				Pid:     int32(e.PID),
+				Message: e.Message,
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package kernelevents
+
+import (
+	"github.com/skushnerchuk/simda/internal/kmsg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(_ *metrics.Env) (<-chan *kmsg.Batch, error) {
+	return nil, metrics.ErrUnsupported
+}
@@ -0,0 +1,12 @@
+//go:build linux
+
+package kernelevents
+
+import (
+	"github.com/skushnerchuk/simda/internal/kmsg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan *kmsg.Batch, error) {
+	return kmsg.NewLinuxKmsgCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,70 @@
+package loadavg
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	collector "github.com/skushnerchuk/simda/internal/load_avg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorLoadAvg
+
+func init() {
+	metrics.Register(metrics.Metric[*collector.AvgStat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.LoadAvg },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.LoadAvg = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.LoadAvg = cfg.Metrics.LoadAvg
+		},
+		Apply: func(env *metrics.Env, data []*collector.AvgStat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.LoadAvg = calculate(env.Cfg, env.Aggregations, data)
+		},
+	})
+}
+
+// calculate усредняет загрузку за окно и считает по ней запрошенные функции агрегации.
+func calculate(cfg *config.DaemonConfig, aggregations []string, data []*collector.AvgStat) *pb.LoadAverage {
+	if !cfg.Metrics.LoadAvg {
+		return nil
+	}
+	if len(data) == 0 {
+		return &pb.LoadAverage{}
+	}
+
+	result := &pb.LoadAverage{
+		One:     0,
+		Five:    0,
+		Fifteen: 0,
+	}
+
+	one := make([]float64, 0, len(data))
+	five := make([]float64, 0, len(data))
+	fifteen := make([]float64, 0, len(data))
+	for _, stat := range data {
+		result.One += stat.Load1
+		result.Five += stat.Load5
+		result.Fifteen += stat.Load15
+		one = append(one, stat.Load1)
+		five = append(five, stat.Load5)
+		fifteen = append(fifteen, stat.Load15)
+	}
+
+	result.One /= float64(len(data))
+	result.Five /= float64(len(data))
+	result.Fifteen /= float64(len(data))
+	result.OneStats = metrics.Aggregate(one, aggregations)
+	result.FiveStats = metrics.Aggregate(five, aggregations)
+	result.FifteenStats = metrics.Aggregate(fifteen, aggregations)
+
+	// Количество задач и последний pid берутся из последнего измерения
+	if len(data) > 0 {
+		last := data[len(data)-1]
+		result.RunningTasks = last.Running
+		result.TotalThreads = last.Total
+		result.LastPid = last.LastPID
+	}
+
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package loadavg
+
+import (
+	collector "github.com/skushnerchuk/simda/internal/load_avg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan *collector.AvgStat, error) {
+	return collector.NewDarwinLoadAverageCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,12 @@
+//go:build linux
+
+package loadavg
+
+import (
+	collector "github.com/skushnerchuk/simda/internal/load_avg"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan *collector.AvgStat, error) {
+	return collector.NewLinuxLoadAverageCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
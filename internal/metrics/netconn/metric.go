@@ -0,0 +1,98 @@
+package netconn
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Name - имя метрики. Буфер соединений используется также для топа по процессам.
+const Name = config.CollectorNetConnections
+
+func init() {
+	metrics.Register(metrics.Metric[network.ConnectionsStat]{
+		Name: Name,
+		Enabled: func(cfg *config.DaemonConfig) bool {
+			return cfg.Metrics.NetConnections || cfg.Metrics.NetConnectionsStates || cfg.Metrics.NetTopByProcess
+		},
+		Disable: func(cfg *config.DaemonConfig) {
+			cfg.Metrics.NetConnections = false
+			cfg.Metrics.NetConnectionsStates = false
+			cfg.Metrics.NetTopByProcess = false
+			cfg.Metrics.DNS = false
+		},
+		Start: start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.NetConnections = cfg.Metrics.NetConnections
+			m.NetConnectionStates = cfg.Metrics.NetConnectionsStates
+		},
+		Apply: func(env *metrics.Env, data []network.ConnectionsStat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.NetConnections = calculateConnections(env.Cfg, data)
+			snapshot.NetConnectionsStates = calculateStates(env.Cfg, data)
+		},
+	})
+}
+
+func calculateConnections(cfg *config.DaemonConfig, data []network.ConnectionsStat) []*pb.NetConnection {
+	if !cfg.Metrics.NetConnections {
+		return nil
+	}
+	avgData := make(map[string]*network.Connection)
+
+	for _, item := range data {
+		for _, v := range item {
+			v := v
+			avgData[v.SocketID] = &v
+		}
+	}
+
+	result := make([]*pb.NetConnection, 0)
+
+	for _, v := range avgData {
+		item := &pb.NetConnection{
+			Protocol: v.Protocol,
+			User:     v.User,
+			State:    v.State,
+			UserId:   v.UserID,
+			SocketId: v.SocketID,
+		}
+		if v.Process != nil {
+			item.Process = &pb.Process{Pid: uint32(v.Process.Pid), CmdLine: v.Process.CmdLine}
+		}
+		if v.LocalAddress != nil {
+			item.LocalAddr = &pb.SockAddr{Ip: v.LocalAddress.IP.String(), Port: uint32(v.LocalAddress.Port)}
+		}
+		if v.ForeignAddress != nil {
+			item.ForeignAddr = &pb.SockAddr{Ip: v.ForeignAddress.IP.String(), Port: uint32(v.ForeignAddress.Port)}
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func calculateStates(cfg *config.DaemonConfig, data []network.ConnectionsStat) []*pb.NetConnectionStates {
+	if !cfg.Metrics.NetConnectionsStates {
+		return nil
+	}
+	avgData := make(map[string]*network.Connection)
+
+	for _, item := range data {
+		for _, v := range item {
+			v := v
+			avgData[v.SocketID] = &v
+		}
+	}
+
+	states := make(map[string]uint32)
+
+	for _, v := range avgData {
+		states[v.State]++
+	}
+	result := make([]*pb.NetConnectionStates, 0)
+	for k, v := range states {
+		result = append(result, &pb.NetConnectionStates{State: k, Count: v})
+	}
+	return result
+}
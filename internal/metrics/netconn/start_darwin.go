@@ -0,0 +1,12 @@
+//go:build darwin
+
+package netconn
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(_ *metrics.Env) (<-chan network.ConnectionsStat, error) {
+	return nil, metrics.ErrUnsupported
+}
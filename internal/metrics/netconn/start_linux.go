@@ -0,0 +1,12 @@
+//go:build linux
+
+package netconn
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(env *metrics.Env) (<-chan network.ConnectionsStat, error) {
+	return network.NewLinuxConnectionsCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,268 @@
+package netpackets
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/metrics/netconn"
+	"github.com/skushnerchuk/simda/internal/network"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorNetPackets
+
+// Количество доменов в отчете по DNS.
+const dnsTopDomains = 20
+
+func init() {
+	metrics.Register(metrics.Metric[network.NetworkPacketStat]{
+		Name: Name,
+		Enabled: func(cfg *config.DaemonConfig) bool {
+			return cfg.Metrics.NetTopByClients || cfg.Metrics.NetTopByProtocol || cfg.Metrics.NetTopByProcess ||
+				cfg.Metrics.DNS
+		},
+		Disable: func(cfg *config.DaemonConfig) {
+			cfg.Metrics.NetTopByClients = false
+			cfg.Metrics.NetTopByProtocol = false
+			cfg.Metrics.NetTopByProcess = false
+			cfg.Metrics.DNS = false
+		},
+		Start: start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.NetTopByProtocol = cfg.Metrics.NetTopByProtocol
+			m.NetTopByConnection = cfg.Metrics.NetTopByClients
+			m.NetTopByProcess = cfg.Metrics.NetTopByProcess
+			m.Dns = cfg.Metrics.DNS
+		},
+		Apply: func(env *metrics.Env, data []network.NetworkPacketStat, sources metrics.Sources, snapshot *pb.Snapshot) {
+			// Период сборщика определяется при запуске потока, перезагрузка настроек на него не влияет
+			interval := sources.Find(Name).Interval()
+			snapshot.NetTopByProtocol = calcProtocolStat(env.Cfg, env.Aggregations, interval, data)
+			snapshot.NetTopByApplication = calcApplicationStat(env.Cfg, data)
+			snapshot.NetTopByConnection = calcProtocolConnectionStat(env, data)
+			snapshot.NetTopByProcess = calcProcessStat(
+				env.Cfg, env.Aggregations, interval, metrics.Data[network.ConnectionsStat](sources, netconn.Name), data,
+			)
+			snapshot.Dns = calcDNSStat(env.Cfg, data)
+		},
+	})
+}
+
+// calcProtocolStat считает трафик по протоколам за окно. Функции агрегации считаются по скорости
+// протокола в каждом измерении окна, измерения без пакетов протокола дают нулевую скорость.
+func calcProtocolStat(
+	cfg *config.DaemonConfig, aggregations []string, period time.Duration, data []network.NetworkPacketStat,
+) []*pb.NetTopByProtocol {
+	if !cfg.Metrics.NetTopByProtocol {
+		return nil
+	}
+
+	interval := period.Seconds()
+	protocols := make(map[string]uint64)
+	perSecond := make(map[string][]float64)
+	totalBytes := uint64(0)
+	for i, elem := range data {
+		for _, p := range elem {
+			totalBytes += p.PayloadSize
+			protocols[p.Protocol] += p.PayloadSize
+			if _, ok := perSecond[p.Protocol]; !ok {
+				perSecond[p.Protocol] = make([]float64, len(data))
+			}
+			perSecond[p.Protocol][i] += float64(p.PayloadSize) / interval
+		}
+	}
+
+	result := make([]*pb.NetTopByProtocol, 0)
+
+	for protocol, bytes := range protocols {
+		result = append(result, &pb.NetTopByProtocol{
+			Protocol:         protocol,
+			Bytes:            bytes,
+			Percent:          (float64(bytes) / float64(totalBytes)) * 100.0,
+			BytesPerSecStats: metrics.Aggregate(perSecond[protocol], aggregations),
+		})
+	}
+	return result
+}
+
+func calcApplicationStat(cfg *config.DaemonConfig, data []network.NetworkPacketStat) []*pb.NetTopByApplication {
+	if !cfg.Metrics.NetTopByProtocol {
+		return nil
+	}
+
+	type key struct{ protocol, application string }
+	applications := make(map[key]uint64)
+	totalBytes := uint64(0)
+	for _, elem := range data {
+		for _, p := range elem {
+			// Пакеты без транспортного уровня (ARP и т.п.) учитываются только в разбивке по L4
+			if p.Application == "" {
+				continue
+			}
+			totalBytes += p.PayloadSize
+			applications[key{p.Protocol, p.Application}] += p.PayloadSize
+		}
+	}
+
+	result := make([]*pb.NetTopByApplication, 0)
+
+	for k, bytes := range applications {
+		result = append(result, &pb.NetTopByApplication{
+			Protocol:    k.protocol,
+			Application: k.application,
+			Bytes:       bytes,
+			Percent:     (float64(bytes) / float64(totalBytes)) * 100.0,
+		})
+	}
+	return result
+}
+
+func calcProtocolConnectionStat(env *metrics.Env, data []network.NetworkPacketStat) []*pb.NetTopByConnection {
+	if !env.Cfg.Metrics.NetTopByClients {
+		return nil
+	}
+	connections := make(map[string][]network.PacketInfo)
+	for _, elem := range data {
+		for _, p := range elem {
+			if _, ok := connections[p.ConnectionID()]; ok {
+				connections[p.ConnectionID()] = append(connections[p.ConnectionID()], p)
+			} else {
+				connections[p.ConnectionID()] = make([]network.PacketInfo, 0)
+				connections[p.ConnectionID()] = append(connections[p.ConnectionID()], p)
+			}
+		}
+	}
+
+	result := make([]*pb.NetTopByConnection, 0)
+
+	for _, packets := range connections {
+		sourceAddr := &pb.SockAddr{Ip: packets[0].SourceIP}
+		port, _ := strconv.Atoi(packets[0].SourcePort)
+		sourceAddr.Port = uint32(port)
+
+		destinationAddr := &pb.SockAddr{Ip: packets[0].DestinationIP}
+		port, _ = strconv.Atoi(packets[0].DestinationPort)
+		destinationAddr.Port = uint32(port)
+
+		bytes := uint64(0)
+		for _, packet := range packets {
+			bytes += packet.PayloadSize
+		}
+		percent := 0.0
+		if bytes > 0 {
+			percent = (float64(env.Request.Warming) / float64(bytes)) * 100.0
+		}
+
+		result = append(result, &pb.NetTopByConnection{
+			Protocol:        packets[0].Protocol,
+			Bytes:           bytes,
+			Percent:         percent,
+			SourceAddr:      sourceAddr,
+			DestinationAddr: destinationAddr,
+		})
+	}
+	return result
+}
+
+// calcProcessStat распределяет трафик окна по процессам. Для функций агрегации трафик
+// дополнительно распределяется по каждому измерению окна.
+func calcProcessStat(
+	cfg *config.DaemonConfig, aggregations []string, period time.Duration,
+	connectionsData []network.ConnectionsStat, data []network.NetworkPacketStat,
+) []*pb.NetTopByProcess {
+	if !cfg.Metrics.NetTopByProcess || len(data) == 0 {
+		return nil
+	}
+	connections := make(map[string]network.Connection)
+	for _, item := range connectionsData {
+		for _, v := range item {
+			connections[v.SocketID] = v
+		}
+	}
+	sockets := make([]network.Connection, 0, len(connections))
+	for _, v := range connections {
+		sockets = append(sockets, v)
+	}
+
+	packets := make([]network.PacketInfo, 0)
+	for _, elem := range data {
+		packets = append(packets, elem...)
+	}
+
+	// Каждый элемент буфера содержит пакеты, захваченные за период измерений сборщика
+	interval := period.Seconds()
+	seconds := float64(len(data)) * interval
+	localIPs := network.LocalIPs()
+	perSecond := make([]network.ProcessTrafficMap, 0, len(data))
+	if len(aggregations) > 0 {
+		for _, elem := range data {
+			perSecond = append(perSecond, network.TrafficByProcess(sockets, elem, localIPs))
+		}
+	}
+	result := make([]*pb.NetTopByProcess, 0)
+
+	for key, v := range network.TrafficByProcess(sockets, packets, localIPs) {
+		item := &pb.NetTopByProcess{
+			Pid:           uint32(v.Pid),
+			Command:       v.Command,
+			User:          v.User,
+			RxBytesPerSec: float64(v.RxBytes) / seconds,
+			TxBytesPerSec: float64(v.TxBytes) / seconds,
+		}
+		if len(aggregations) > 0 {
+			rx, tx := make([]float64, len(data)), make([]float64, len(data))
+			for i, traffic := range perSecond {
+				if t, ok := traffic[key]; ok {
+					rx[i], tx[i] = float64(t.RxBytes)/interval, float64(t.TxBytes)/interval
+				}
+			}
+			item.RxBytesPerSecStats = metrics.Aggregate(rx, aggregations)
+			item.TxBytesPerSecStats = metrics.Aggregate(tx, aggregations)
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func calcDNSStat(cfg *config.DaemonConfig, data []network.NetworkPacketStat) *pb.DnsStat {
+	if !cfg.Metrics.DNS {
+		return nil
+	}
+
+	packets := make([]network.PacketInfo, 0)
+	for _, elem := range data {
+		packets = append(packets, elem...)
+	}
+	stat := network.CalcDNSStat(packets, dnsTopDomains)
+
+	counters := func(data []network.DNSCounter) []*pb.DnsCounter {
+		result := make([]*pb.DnsCounter, 0, len(data))
+		for _, c := range data {
+			result = append(result, &pb.DnsCounter{Name: c.Name, Count: c.Count})
+		}
+		return result
+	}
+
+	result := &pb.DnsStat{
+		Queries:         stat.Queries,
+		Responses:       stat.Responses,
+		NxdomainPercent: stat.RCodePercent(network.DNSNXDomain),
+		ServfailPercent: stat.RCodePercent(network.DNSServFail),
+		TopDomains:      counters(stat.TopDomains),
+		QueryTypes:      counters(stat.QueryTypes),
+		ResponseCodes:   counters(stat.ResponseCodes),
+		Resolvers:       make([]*pb.DnsResolver, 0, len(stat.Resolvers)),
+	}
+	for _, r := range stat.Resolvers {
+		result.Resolvers = append(result.Resolvers, &pb.DnsResolver{
+			Ip:           r.Resolver,
+			Queries:      r.Queries,
+			Responses:    r.Responses,
+			AvgLatencyMs: float64(r.AvgLatency.Microseconds()) / 1000.0,
+			MaxLatencyMs: float64(r.MaxLatency.Microseconds()) / 1000.0,
+		})
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+//go:build linux
+
+package netpackets
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(env *metrics.Env) (<-chan network.NetworkPacketStat, error) {
+	c := network.NewLinuxNetworkPackagesCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log, env.Request)
+	return c.Run()
+}
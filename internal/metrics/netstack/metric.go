@@ -0,0 +1,79 @@
+package netstack
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorNetStack
+
+func init() {
+	metrics.Register(metrics.Metric[*network.NetStackStat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.NetStack },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.NetStack = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.NetStack = cfg.Metrics.NetStack
+		},
+		Apply: func(env *metrics.Env, data []*network.NetStackStat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.NetStack = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate усредняет скорости счетчиков, сведения о сокетах берутся из последнего измерения.
+func calculate(cfg *config.DaemonConfig, data []*network.NetStackStat) *pb.NetStack {
+	if !cfg.Metrics.NetStack {
+		return nil
+	}
+	if len(data) == 0 {
+		return &pb.NetStack{}
+	}
+
+	last := data[len(data)-1]
+	result := &pb.NetStack{
+		SocketsUsed: last.SocketsUsed,
+		TcpInUse:    last.TCPInUse,
+		TcpOrphan:   last.TCPOrphan,
+		TcpTimeWait: last.TCPTimeWait,
+		TcpAlloc:    last.TCPAlloc,
+		TcpMemBytes: last.TCPMemBytes,
+		UdpInUse:    last.UDPInUse,
+		UdpMemBytes: last.UDPMemBytes,
+		Tcp6InUse:   last.TCP6InUse,
+		Udp6InUse:   last.UDP6InUse,
+	}
+	for _, stat := range data {
+		result.TcpRetransSegs += stat.TCPRetransSegs
+		result.TcpOutRsts += stat.TCPOutRsts
+		result.TcpEstabResets += stat.TCPEstabResets
+		result.TcpAttemptFails += stat.TCPAttemptFails
+		result.TcpInErrs += stat.TCPInErrs
+		result.ListenOverflows += stat.ListenOverflows
+		result.ListenDrops += stat.ListenDrops
+		result.UdpRcvbufErrors += stat.UDPRcvbufErrors
+		result.UdpSndbufErrors += stat.UDPSndbufErrors
+		result.UdpInErrors += stat.UDPInErrors
+		result.IcmpInErrors += stat.ICMPInErrors
+		result.IcmpOutErrors += stat.ICMPOutErrors
+	}
+
+	n := float64(len(data))
+	result.TcpRetransSegs /= n
+	result.TcpOutRsts /= n
+	result.TcpEstabResets /= n
+	result.TcpAttemptFails /= n
+	result.TcpInErrs /= n
+	result.ListenOverflows /= n
+	result.ListenDrops /= n
+	result.UdpRcvbufErrors /= n
+	result.UdpSndbufErrors /= n
+	result.UdpInErrors /= n
+	result.IcmpInErrors /= n
+	result.IcmpOutErrors /= n
+
+	return result
+}
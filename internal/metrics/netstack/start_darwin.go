@@ -0,0 +1,12 @@
+//go:build darwin
+
+package netstack
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(_ *metrics.Env) (<-chan *network.NetStackStat, error) {
+	return nil, metrics.ErrUnsupported
+}
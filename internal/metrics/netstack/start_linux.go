@@ -0,0 +1,12 @@
+//go:build linux
+
+package netstack
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+func start(env *metrics.Env) (<-chan *network.NetStackStat, error) {
+	return network.NewLinuxNetStackCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
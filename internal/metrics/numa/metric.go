@@ -0,0 +1,83 @@
+package numa
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/numa"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorNUMA
+
+func init() {
+	metrics.Register(metrics.Metric[*collector.Stat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.NUMA },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.NUMA = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Numa = cfg.Metrics.NUMA
+		},
+		Apply: func(env *metrics.Env, data []*collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Numa = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate усредняет скорости numastat по измерениям, в которых присутствовал узел.
+// Память узлов и пулы больших страниц берутся из последнего измерения.
+func calculate(cfg *config.DaemonConfig, data []*collector.Stat) *pb.Numa {
+	if !cfg.Metrics.NUMA {
+		return nil
+	}
+	result := &pb.Numa{}
+	if len(data) == 0 {
+		return result
+	}
+
+	type avg struct {
+		node  *pb.NumaNode
+		count int
+	}
+	avgData := make(map[int]*avg)
+	for _, item := range data {
+		for _, v := range item.Nodes {
+			a, ok := avgData[v.Node]
+			if !ok {
+				a = &avg{node: &pb.NumaNode{Node: uint32(v.Node)}}
+				avgData[v.Node] = a
+				result.Nodes = append(result.Nodes, a.node)
+			}
+			a.node.MemTotal = v.MemTotal
+			a.node.MemFree = v.MemFree
+			a.node.MemUsed = v.MemUsed
+			a.node.NumaHit += v.NumaHit
+			a.node.NumaMiss += v.NumaMiss
+			a.node.NumaForeign += v.NumaForeign
+			a.node.InterleaveHit += v.InterleaveHit
+			a.node.LocalNode += v.LocalNode
+			a.node.OtherNode += v.OtherNode
+			a.count++
+		}
+	}
+	for _, a := range avgData {
+		n := float64(a.count)
+		a.node.NumaHit /= n
+		a.node.NumaMiss /= n
+		a.node.NumaForeign /= n
+		a.node.InterleaveHit /= n
+		a.node.LocalNode /= n
+		a.node.OtherNode /= n
+	}
+
+	for _, v := range data[len(data)-1].HugePages {
+		result.HugePages = append(result.HugePages, &pb.HugePages{
+			SizeBytes: v.SizeBytes,
+			Total:     v.Total,
+			Free:      v.Free,
+			Reserved:  v.Reserved,
+			Surplus:   v.Surplus,
+		})
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build darwin
+
+package numa
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/numa"
+)
+
+func start(_ *metrics.Env) (<-chan *collector.Stat, error) {
+	return nil, metrics.ErrUnsupported
+}
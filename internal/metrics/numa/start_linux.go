@@ -0,0 +1,12 @@
+//go:build linux
+
+package numa
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/numa"
+)
+
+func start(env *metrics.Env) (<-chan *collector.Stat, error) {
+	return collector.NewLinuxNUMACollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,64 @@
+package plugins
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/plugins"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorPlugins
+
+func init() {
+	metrics.Register(metrics.Metric[collector.Stat]{
+		Name:    Name,
+		Enabled: enabled,
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.Plugins = false },
+		// Плагины - внешние команды, сборщик не зависит от платформы
+		Start: func(env *metrics.Env) (<-chan collector.Stat, error) {
+			return collector.NewExecCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log, env.Plugins).Run()
+		},
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Plugins = enabled(cfg)
+		},
+		Apply: func(env *metrics.Env, data []collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.CustomMetrics = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// enabled сообщает, включена ли метрика. Без описанных плагинов метрика считается выключенной.
+func enabled(cfg *config.DaemonConfig) bool {
+	return cfg.Metrics.Plugins && len(cfg.Plugins) > 0
+}
+
+// calculate усредняет значения плагинов по измерениям, в которых они присутствовали.
+func calculate(cfg *config.DaemonConfig, data []collector.Stat) []*pb.CustomMetric {
+	if !cfg.Metrics.Plugins {
+		return nil
+	}
+
+	type avg struct {
+		metric *pb.CustomMetric
+		count  int
+	}
+	avgData := make(map[string]*avg)
+	result := make([]*pb.CustomMetric, 0)
+	for _, item := range data {
+		for _, v := range item {
+			key := v.Key()
+			a, ok := avgData[key]
+			if !ok {
+				a = &avg{metric: &pb.CustomMetric{Plugin: v.Plugin, Name: v.Name, Labels: v.Labels}}
+				avgData[key] = a
+				result = append(result, a.metric)
+			}
+			a.metric.Value += v.Value
+			a.count++
+		}
+	}
+	for _, a := range avgData {
+		a.metric.Value /= float64(a.count)
+	}
+	return result
+}
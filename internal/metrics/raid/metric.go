@@ -0,0 +1,57 @@
+package raid
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	collector "github.com/skushnerchuk/simda/internal/disk/raid"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorRAID
+
+func init() {
+	metrics.Register(metrics.Metric[collector.Stat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.RAID },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.RAID = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Raid = cfg.Metrics.RAID
+		},
+		Apply: func(env *metrics.Env, data []collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Raid = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate возвращает состояние массивов из последнего измерения.
+func calculate(cfg *config.DaemonConfig, data []collector.Stat) []*pb.RaidArray {
+	if !cfg.Metrics.RAID || len(data) == 0 {
+		return nil
+	}
+	result := make([]*pb.RaidArray, 0)
+	for _, a := range data[len(data)-1] {
+		array := &pb.RaidArray{
+			Name:         a.Name,
+			State:        a.State,
+			Level:        a.Level,
+			DisksTotal:   int32(a.DisksTotal),
+			DisksActive:  int32(a.DisksActive),
+			Degraded:     a.Degraded,
+			SyncAction:   a.SyncAction,
+			SyncProgress: a.SyncProgress,
+			SyncFinish:   a.SyncFinish,
+			SyncSpeed:    a.SyncSpeed,
+		}
+		for _, m := range a.Members {
+			array.Members = append(array.Members, &pb.RaidMember{
+				Device: m.Device,
+				Disk:   m.Disk,
+				Role:   int32(m.Role),
+				State:  m.State,
+			})
+		}
+		result = append(result, array)
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build linux
+
+package raid
+
+import (
+	collector "github.com/skushnerchuk/simda/internal/disk/raid"
+	"github.com/skushnerchuk/simda/internal/metrics"
+)
+
+func start(env *metrics.Env) (<-chan collector.Stat, error) {
+	return collector.NewLinuxRAIDCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
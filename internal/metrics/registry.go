@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/disk/forecast"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/plugins"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// ErrUnsupported возвращается сборщиком, если метрика не поддерживается платформой.
+var ErrUnsupported = errors.New("metric is not supported on this platform")
+
+// Shared - данные, которые собираются один раз на уровне демона и используются всеми потоками.
+// History - история использования файловых систем, DiskUsage - измерения общего сборщика
+// использования файловых систем, Plugins - значения плагинов. Незаданные поля означают,
+// что поток собирает данные сам.
+type Shared struct {
+	History   *forecast.History
+	DiskUsage *disk.UsageFeed
+	Plugins   *plugins.Runner
+}
+
+// Env - окружение, в котором создаются сборщики и формируются снимки для одного клиента.
+// Aggregations - функции агрегации, запрошенные клиентом или заданные в настройках.
+// Collectors - имена сборщиков, которые нужно запустить, пустой список означает все сборщики.
+type Env struct {
+	Shared
+	ServerCtx    context.Context
+	ClientCtx    context.Context
+	Cfg          *config.DaemonConfig
+	Log          logger.Logger
+	Request      *pb.Request
+	Aggregations []string
+	Collectors   []string
+}
+
+// selected сообщает, нужно ли запускать сборщик name.
+func (e *Env) selected(name string) bool {
+	if len(e.Collectors) == 0 {
+		return true
+	}
+	for _, v := range e.Collectors {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Metric - описание метрики. Один сборщик может заполнять несколько полей снимка,
+// например, захват пакетов используется для топов по протоколам, соединениям и для DNS.
+type Metric[T any] struct {
+	// Name - уникальное имя, по которому буфер метрики доступен другим метрикам
+	Name string
+	// Enabled сообщает, включена ли в настройках хотя бы одна метрика, которую заполняет сборщик
+	Enabled func(cfg *config.DaemonConfig) bool
+	// Disable отключает метрики сборщика, если его не удалось запустить
+	Disable func(cfg *config.DaemonConfig)
+	// Start создает и запускает сборщик
+	Start func(env *Env) (<-chan T, error)
+	// Flags переносит признаки включения метрик из настроек в снимок
+	Flags func(cfg *config.DaemonConfig, m *pb.EnabledMetrics)
+	// Apply заполняет снимок по накопленным измерениям. Выключенные метрики остаются пустыми
+	Apply func(env *Env, data []T, sources Sources, snapshot *pb.Snapshot)
+}
+
+type definition interface {
+	start(env *Env, out chan<- Sample) Source
+	flags(cfg *config.DaemonConfig, m *pb.EnabledMetrics)
+}
+
+var registry []definition
+
+// Register добавляет метрику в реестр. Вызывается из init пакета метрики.
+func Register[T any](m Metric[T]) {
+	registry = append(registry, &m)
+}
+
+func (m *Metric[T]) flags(cfg *config.DaemonConfig, em *pb.EnabledMetrics) {
+	m.Flags(cfg, em)
+}
+
+func (m *Metric[T]) start(env *Env, out chan<- Sample) Source {
+	s := &source[T]{metric: m, env: env, interval: env.Cfg.Interval(m.Name), selected: env.selected(m.Name)}
+	if !s.selected {
+		return s
+	}
+	ch, err := m.Start(env)
+	if err != nil {
+		if !errors.Is(err, ErrUnsupported) {
+			env.Log.Error("Failed to create collector, metrics disabled", "collector", m.Name, "error", err.Error())
+		}
+		m.Disable(env.Cfg)
+		return s
+	}
+	go s.forward(ch, out)
+	return s
+}
+
+// Flags возвращает признаки включения всех зарегистрированных метрик.
+func Flags(cfg *config.DaemonConfig) *pb.EnabledMetrics {
+	result := &pb.EnabledMetrics{}
+	for _, d := range registry {
+		d.flags(cfg, result)
+	}
+	return result
+}
+
+// Start запускает сборщики зарегистрированных метрик. Измерения поступают в out, сборщик, который
+// не удалось запустить, отключает свои метрики в настройках и остается в списке с пустым буфером.
+// Сборщик, который не выбран в env, не запускается и считается выключенным только в этом потоке.
+func Start(env *Env, out chan<- Sample) Sources {
+	result := make(Sources, 0, len(registry))
+	for _, d := range registry {
+		result = append(result, d.start(env, out))
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func testMetric(name string, start func(env *Env) (<-chan float64, error)) Metric[float64] {
+	return Metric[float64]{
+		Name:    name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.LoadAvg },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.LoadAvg = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.LoadAvg = cfg.Metrics.LoadAvg
+		},
+		Apply: func(_ *Env, data []float64, sources Sources, snapshot *pb.Snapshot) {
+			snapshot.LoadAvg = &pb.LoadAverage{}
+			for _, v := range data {
+				snapshot.LoadAvg.One += v
+			}
+			for _, v := range Data[float64](sources, "other") {
+				snapshot.LoadAvg.Five += v
+			}
+		},
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	defer func(saved []definition) { registry = saved }(registry)
+	registry = nil
+
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := &Env{ServerCtx: ctx, ClientCtx: ctx, Cfg: &config.DaemonConfig{}, Log: log}
+	env.Cfg.Metrics.LoadAvg = true
+
+	values := make(chan float64)
+	Register(testMetric("main", func(_ *Env) (<-chan float64, error) { return values, nil }))
+	Register(testMetric("other", func(_ *Env) (<-chan float64, error) { return nil, ErrUnsupported }))
+
+	require.True(t, Flags(env.Cfg).LoadAvg)
+
+	samples := make(chan Sample)
+	sources := Start(env, samples)
+	require.Len(t, sources, 2)
+	// Сборщик, который не удалось запустить, отключает свои метрики
+	require.False(t, env.Cfg.Metrics.LoadAvg)
+	require.False(t, Flags(env.Cfg).LoadAvg)
+
+	now := time.Now()
+	for i, v := range []float64{1, 2, 3} {
+		values <- v
+		sample := <-samples
+		require.Equal(t, "main", sample.Source.Name())
+		sample.Source.Append(sample.Value, now.Add(time.Duration(i)*time.Second))
+	}
+	source := sources.Find("main")
+	require.Equal(t, 3, source.Len())
+	other := sources.Find("other")
+	other.Append(10.0, now)
+
+	snapshot := &pb.Snapshot{}
+	source.Apply(sources, snapshot)
+	require.Equal(t, 6.0, snapshot.LoadAvg.One)
+	require.Equal(t, 10.0, snapshot.LoadAvg.Five)
+
+	source.Trim(now.Add(time.Second))
+	require.Equal(t, []float64{2, 3}, Data[float64](sources, "main"))
+	// Последнее измерение остается в буфере, даже если оно старше окна
+	source.Trim(now.Add(time.Minute))
+	require.Equal(t, []float64{3}, Data[float64](sources, "main"))
+	require.Nil(t, Data[int](sources, "main"))
+	require.Nil(t, sources.Find("missing"))
+
+	close(values)
+}
+
+func TestRegistryStartError(t *testing.T) {
+	defer func(saved []definition) { registry = saved }(registry)
+	registry = nil
+
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	env := &Env{ServerCtx: context.TODO(), ClientCtx: context.TODO(), Cfg: &config.DaemonConfig{}, Log: log}
+	env.Cfg.Metrics.LoadAvg = true
+
+	Register(testMetric("broken", func(_ *Env) (<-chan float64, error) { return nil, errors.New("broken") }))
+	sources := Start(env, make(chan Sample))
+	require.Equal(t, 0, sources.Find("broken").Len())
+	require.False(t, sources.Find("broken").Enabled())
+}
+
+func TestRegistryCollectors(t *testing.T) {
+	defer func(saved []definition) { registry = saved }(registry)
+	registry = nil
+
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	env := &Env{
+		ServerCtx: context.TODO(), ClientCtx: context.TODO(), Cfg: &config.DaemonConfig{}, Log: log,
+		Collectors: []string{"main"},
+	}
+	env.Cfg.Metrics.LoadAvg = true
+
+	started := false
+	Register(testMetric("other", func(_ *Env) (<-chan float64, error) {
+		started = true
+		return nil, nil
+	}))
+	sources := Start(env, make(chan Sample))
+	// Невыбранный сборщик не запускается и выключен только в своем потоке, общие настройки не меняются
+	require.False(t, started)
+	other := sources.Find("other")
+	require.NotNil(t, other)
+	require.True(t, env.Cfg.Metrics.LoadAvg)
+	require.False(t, other.Enabled())
+	require.False(t, sources.Flags().LoadAvg)
+	snapshot := &pb.Snapshot{}
+	other.Apply(sources, snapshot)
+	require.Nil(t, snapshot.LoadAvg)
+}
@@ -0,0 +1,67 @@
+package sensors
+
+import (
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/sensors"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+const Name = config.CollectorSensors
+
+func init() {
+	metrics.Register(metrics.Metric[collector.Stat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.Sensors },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.Sensors = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Sensors = cfg.Metrics.Sensors
+		},
+		Apply: func(env *metrics.Env, data []collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Sensors = calculate(env.Cfg, data)
+		},
+	})
+}
+
+func calculate(cfg *config.DaemonConfig, data []collector.Stat) []*pb.Sensor {
+	if !cfg.Metrics.Sensors {
+		return nil
+	}
+
+	type avg struct {
+		sensor collector.Sensor
+		sum    float64
+		count  int
+	}
+	avgData := make(map[string]*avg)
+	order := make([]string, 0)
+	for _, item := range data {
+		for _, v := range item {
+			a, ok := avgData[v.Source]
+			if !ok {
+				a = &avg{}
+				avgData[v.Source] = a
+				order = append(order, v.Source)
+			}
+			// Пороги берутся из последнего измерения
+			a.sensor = v
+			a.sum += v.Value
+			a.count++
+		}
+	}
+
+	result := make([]*pb.Sensor, 0, len(order))
+	for _, source := range order {
+		a := avgData[source]
+		result = append(result, &pb.Sensor{
+			Chip:     a.sensor.Chip,
+			Label:    a.sensor.Label,
+			Kind:     a.sensor.Kind,
+			Value:    a.sum / float64(a.count),
+			Max:      a.sensor.Max,
+			Critical: a.sensor.Critical,
+		})
+	}
+	return result
+}
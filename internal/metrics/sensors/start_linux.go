@@ -0,0 +1,12 @@
+//go:build linux
+
+package sensors
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/sensors"
+)
+
+func start(env *metrics.Env) (<-chan collector.Stat, error) {
+	return collector.NewLinuxSensorsCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
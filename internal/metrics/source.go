@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"time"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Sample - измерение, полученное от сборщика.
+type Sample struct {
+	Source Source
+	Value  interface{}
+}
+
+// Source - запущенный сборщик метрики с буфером измерений.
+type Source interface {
+	Name() string
+	Enabled() bool
+	Len() int
+	// Interval возвращает период измерений сборщика, определенный при его запуске
+	Interval() time.Duration
+	// Append добавляет в буфер измерение, полученное в момент at
+	Append(value interface{}, at time.Time)
+	// Flags переносит в m признаки включения метрик сборщика
+	Flags(m *pb.EnabledMetrics)
+	// Trim удаляет из буфера измерения, полученные раньше since. Последнее измерение сохраняется,
+	// чтобы сборщик с периодом больше окна не оставлял снимок пустым
+	Trim(since time.Time)
+	Apply(sources Sources, snapshot *pb.Snapshot)
+}
+
+type Sources []Source
+
+// Flags возвращает признаки включения метрик сборщиков потока.
+func (s Sources) Flags() *pb.EnabledMetrics {
+	result := &pb.EnabledMetrics{}
+	for _, item := range s {
+		item.Flags(result)
+	}
+	return result
+}
+
+// Find возвращает сборщик по имени метрики.
+func (s Sources) Find(name string) Source {
+	for _, item := range s {
+		if item.Name() == name {
+			return item
+		}
+	}
+	return nil
+}
+
+// Data возвращает буфер измерений метрики name, если его тип совпадает с T.
+func Data[T any](sources Sources, name string) []T {
+	if s, ok := sources.Find(name).(*source[T]); ok {
+		return s.data
+	}
+	return nil
+}
+
+// source - сборщик метрики в потоке. Невыбранный сборщик (selected = false) не запускается,
+// а его метрики не заполняются в снимках потока независимо от настроек.
+type source[T any] struct {
+	metric   *Metric[T]
+	env      *Env
+	interval time.Duration
+	selected bool
+	data     []T
+	times    []time.Time
+}
+
+// forward передает измерения сборщика в общий канал до закрытия канала сборщика
+// или отключения клиента.
+func (s *source[T]) forward(ch <-chan T, out chan<- Sample) {
+	for v := range ch {
+		select {
+		case out <- Sample{Source: s, Value: v}:
+		case <-s.env.ClientCtx.Done():
+			return
+		}
+	}
+}
+
+func (s *source[T]) Name() string {
+	return s.metric.Name
+}
+
+func (s *source[T]) Enabled() bool {
+	return s.selected && s.metric.Enabled(s.env.Cfg)
+}
+
+func (s *source[T]) Len() int {
+	return len(s.data)
+}
+
+func (s *source[T]) Interval() time.Duration {
+	return s.interval
+}
+
+func (s *source[T]) Flags(m *pb.EnabledMetrics) {
+	if s.selected {
+		s.metric.Flags(s.env.Cfg, m)
+	}
+}
+
+func (s *source[T]) Append(value interface{}, at time.Time) {
+	s.data = append(s.data, value.(T))
+	s.times = append(s.times, at)
+}
+
+func (s *source[T]) Trim(since time.Time) {
+	n := 0
+	for n < len(s.times)-1 && s.times[n].Before(since) {
+		n++
+	}
+	s.data = s.data[n:]
+	s.times = s.times[n:]
+}
+
+func (s *source[T]) Apply(sources Sources, snapshot *pb.Snapshot) {
+	if !s.selected {
+		return
+	}
+	s.metric.Apply(s.env, s.data, sources, snapshot)
+}
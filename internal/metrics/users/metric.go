@@ -0,0 +1,69 @@
+package users
+
+import (
+	"sort"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	collector "github.com/skushnerchuk/simda/internal/users"
+)
+
+const Name = config.CollectorUsers
+
+func init() {
+	metrics.Register(metrics.Metric[collector.Stat]{
+		Name:    Name,
+		Enabled: func(cfg *config.DaemonConfig) bool { return cfg.Metrics.Users },
+		Disable: func(cfg *config.DaemonConfig) { cfg.Metrics.Users = false },
+		Start:   start,
+		Flags: func(cfg *config.DaemonConfig, m *pb.EnabledMetrics) {
+			m.Users = cfg.Metrics.Users
+		},
+		Apply: func(env *metrics.Env, data []collector.Stat, _ metrics.Sources, snapshot *pb.Snapshot) {
+			snapshot.Users = calculate(env.Cfg, data)
+		},
+	})
+}
+
+// calculate усредняет загрузку процессора и память по измерениям, в которых присутствовал пользователь.
+// Количество процессов, соединений и порты берутся из последнего такого измерения.
+func calculate(cfg *config.DaemonConfig, data []collector.Stat) []*pb.UserUsage {
+	if !cfg.Metrics.Users {
+		return nil
+	}
+
+	type avg struct {
+		usage *pb.UserUsage
+		rss   uint64
+		count int
+	}
+	avgData := make(map[uint32]*avg)
+	result := make([]*pb.UserUsage, 0)
+	for _, item := range data {
+		for _, v := range item {
+			a, ok := avgData[v.UID]
+			if !ok {
+				a = &avg{usage: &pb.UserUsage{Uid: v.UID}}
+				avgData[v.UID] = a
+				result = append(result, a.usage)
+			}
+			a.usage.User = v.User
+			a.usage.Processes = uint32(v.Processes)
+			a.usage.Connections = uint32(v.Connections)
+			a.usage.ListenPorts = make([]uint32, 0, len(v.ListenPorts))
+			for _, port := range v.ListenPorts {
+				a.usage.ListenPorts = append(a.usage.ListenPorts, uint32(port))
+			}
+			a.usage.CpuPercent += v.CPUPercent
+			a.rss += v.RSSBytes
+			a.count++
+		}
+	}
+	for _, a := range avgData {
+		a.usage.CpuPercent /= float64(a.count)
+		a.usage.RssBytes = a.rss / uint64(a.count)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CpuPercent > result[j].CpuPercent })
+	return result
+}
@@ -0,0 +1,12 @@
+//go:build linux
+
+package users
+
+import (
+	"github.com/skushnerchuk/simda/internal/metrics"
+	collector "github.com/skushnerchuk/simda/internal/users"
+)
+
+func start(env *metrics.Env) (<-chan collector.Stat, error) {
+	return collector.NewLinuxUsersCollector(env.ServerCtx, env.ClientCtx, env.Cfg, env.Log).Run()
+}
@@ -0,0 +1,227 @@
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/skushnerchuk/simda/internal/config"
+)
+
+const (
+	ApplicationUnknown = "Unknown"
+
+	// Максимальное количество потоков, для которых запоминается результат инспекции.
+	maxClassifiedFlows = 65536
+
+	dnsHeaderLen         = 12
+	tlsRecordHeaderLen   = 5
+	pgStartupHeaderLen   = 8
+	pgProtocolVersion3   = 0x00030000
+	pgSSLRequestCode     = 80877103
+	tlsHandshakeRecord   = 0x16
+	tlsClientHelloType   = 0x01
+	tlsServerHelloType   = 0x02
+	tlsMajorVersion      = 0x03
+	tlsMaxMinorVersion   = 0x04
+	tlsMinRecordType     = 0x14
+	tlsMaxRecordType     = 0x17
+	dnsMaxQuestionsCount = 16
+)
+
+// Порты хорошо известных сервисов, используются, если инспекция содержимого не дала результата.
+var defaultServices = []config.Service{
+	{Name: "FTP", Protocol: ProtocolTCP, Port: 21},
+	{Name: "SSH", Protocol: ProtocolTCP, Port: 22},
+	{Name: "SMTP", Protocol: ProtocolTCP, Port: 25},
+	{Name: "DNS", Protocol: ProtocolTCP, Port: 53},
+	{Name: "DNS", Protocol: ProtocolUDP, Port: 53},
+	{Name: "DHCP", Protocol: ProtocolUDP, Port: 67},
+	{Name: "DHCP", Protocol: ProtocolUDP, Port: 68},
+	{Name: "HTTP", Protocol: ProtocolTCP, Port: 80},
+	{Name: "NTP", Protocol: ProtocolUDP, Port: 123},
+	{Name: "IMAP", Protocol: ProtocolTCP, Port: 143},
+	{Name: "SNMP", Protocol: ProtocolUDP, Port: 161},
+	{Name: "LDAP", Protocol: ProtocolTCP, Port: 389},
+	{Name: "TLS", Protocol: ProtocolTCP, Port: 443},
+	{Name: "QUIC", Protocol: ProtocolUDP, Port: 443},
+	{Name: "SMTP", Protocol: ProtocolTCP, Port: 587},
+	{Name: "IMAP", Protocol: ProtocolTCP, Port: 993},
+	{Name: "MySQL", Protocol: ProtocolTCP, Port: 3306},
+	{Name: "PostgreSQL", Protocol: ProtocolTCP, Port: 5432},
+	{Name: "mDNS", Protocol: ProtocolUDP, Port: 5353},
+	{Name: "Redis", Protocol: ProtocolTCP, Port: 6379},
+	{Name: "HTTP", Protocol: ProtocolTCP, Port: 8080},
+	{Name: "Memcached", Protocol: ProtocolTCP, Port: 11211},
+	{Name: "MongoDB", Protocol: ProtocolTCP, Port: 27017},
+	{Name: "gRPC", Protocol: ProtocolTCP, Port: 50051},
+}
+
+var httpPrefixes = [][]byte{
+	[]byte("GET "), []byte("POST "), []byte("PUT "), []byte("HEAD "), []byte("DELETE "),
+	[]byte("OPTIONS "), []byte("PATCH "), []byte("CONNECT "), []byte("HTTP/1."),
+}
+
+// Classifier определяет прикладной протокол пакета по его содержимому и портам.
+type Classifier struct {
+	ports map[string]string
+	flows map[string]string
+}
+
+func NewClassifier(services []config.Service) *Classifier {
+	c := &Classifier{
+		ports: make(map[string]string, len(defaultServices)+len(services)),
+		flows: make(map[string]string),
+	}
+	// Настройки из конфигурации переопределяют встроенную карту
+	for _, list := range [][]config.Service{defaultServices, services} {
+		for _, s := range list {
+			c.ports[portKey(s.Protocol, strconv.Itoa(int(s.Port)))] = s.Name
+		}
+	}
+	return c
+}
+
+func portKey(protocol, port string) string {
+	return normalizeProtocol(protocol) + "/" + port
+}
+
+// flowKey не зависит от направления пакета, чтобы ответы наследовали результат инспекции запроса.
+func flowKey(protocol, srcIP, srcPort, dstIP, dstPort string) string {
+	a, b := srcIP+":"+srcPort, dstIP+":"+dstPort
+	if a > b {
+		a, b = b, a
+	}
+	return normalizeProtocol(protocol) + " " + a + "-" + b
+}
+
+// Classify возвращает имя прикладного протокола. Для пакетов без транспортного уровня возвращается пустая строка.
+func (c *Classifier) Classify(info *PacketInfo, payload []byte) string {
+	protocol := normalizeProtocol(info.Protocol)
+	if protocol != ProtocolTCP && protocol != ProtocolUDP {
+		return ""
+	}
+
+	key := flowKey(protocol, info.SourceIP, info.SourcePort, info.DestinationIP, info.DestinationPort)
+	if app, ok := c.flows[key]; ok {
+		return app
+	}
+
+	if app := inspectPayload(protocol, payload); app != "" {
+		if len(c.flows) >= maxClassifiedFlows {
+			c.flows = make(map[string]string)
+		}
+		c.flows[key] = app
+		return app
+	}
+
+	return c.byPorts(protocol, info.SourcePort, info.DestinationPort)
+}
+
+func (c *Classifier) byPorts(protocol, srcPort, dstPort string) string {
+	src, srcOk := c.ports[portKey(protocol, srcPort)]
+	dst, dstOk := c.ports[portKey(protocol, dstPort)]
+	switch {
+	case srcOk && dstOk:
+		// Сервис, как правило, слушает на меньшем порту
+		s, _ := strconv.Atoi(srcPort)
+		d, _ := strconv.Atoi(dstPort)
+		if s < d {
+			return src
+		}
+		return dst
+	case dstOk:
+		return dst
+	case srcOk:
+		return src
+	default:
+		return ApplicationUnknown
+	}
+}
+
+func inspectPayload(protocol string, payload []byte) string {
+	if len(payload) == 0 {
+		return ""
+	}
+	if protocol == ProtocolUDP {
+		if isDNS(payload) {
+			return "DNS"
+		}
+		return ""
+	}
+	switch {
+	case isTLS(payload):
+		return "TLS"
+	case isHTTP(payload):
+		return "HTTP"
+	case bytes.HasPrefix(payload, []byte("SSH-")):
+		return "SSH"
+	case isPostgreSQL(payload):
+		return "PostgreSQL"
+	case isRedis(payload):
+		return "Redis"
+	}
+	return ""
+}
+
+func isTLS(p []byte) bool {
+	if len(p) < tlsRecordHeaderLen || p[1] != tlsMajorVersion || p[2] > tlsMaxMinorVersion {
+		return false
+	}
+	// ClientHello определяем однозначно, остальные записи - по типу
+	if p[0] == tlsHandshakeRecord && len(p) > tlsRecordHeaderLen {
+		return p[tlsRecordHeaderLen] == tlsClientHelloType || p[tlsRecordHeaderLen] == tlsServerHelloType
+	}
+	return p[0] >= tlsMinRecordType && p[0] <= tlsMaxRecordType
+}
+
+func isHTTP(p []byte) bool {
+	for _, prefix := range httpPrefixes {
+		if bytes.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPostgreSQL(p []byte) bool {
+	if len(p) < pgStartupHeaderLen {
+		return false
+	}
+	length := binary.BigEndian.Uint32(p[0:4])
+	code := binary.BigEndian.Uint32(p[4:8])
+	return int(length) == len(p) && (code == pgProtocolVersion3 || code == pgSSLRequestCode)
+}
+
+func isRedis(p []byte) bool {
+	if len(p) < 4 || p[0] != '*' || p[1] < '0' || p[1] > '9' {
+		return false
+	}
+	return strings.Contains(string(p), "\r\n$")
+}
+
+func isDNS(p []byte) bool {
+	if len(p) < dnsHeaderLen {
+		return false
+	}
+	flags := binary.BigEndian.Uint16(p[2:4])
+	opcode := (flags >> 11) & 0x0f
+	questions := binary.BigEndian.Uint16(p[4:6])
+	if opcode > 2 || questions == 0 || questions > dnsMaxQuestionsCount {
+		return false
+	}
+	// Проверяем, что имя в первом вопросе корректно закодировано
+	i := dnsHeaderLen
+	for i < len(p) {
+		l := int(p[i])
+		if l == 0 {
+			return i+5 <= len(p)
+		}
+		if l > 63 {
+			return false
+		}
+		i += l + 1
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package network
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func packet(protocol, srcPort, dstPort string) *PacketInfo {
+	return &PacketInfo{
+		Protocol:        protocol,
+		SourceIP:        "10.0.0.1",
+		SourcePort:      srcPort,
+		DestinationIP:   "10.0.0.2",
+		DestinationPort: dstPort,
+	}
+}
+
+func TestClassifier(t *testing.T) {
+	t.Run("classify by payload", func(t *testing.T) {
+		c := NewClassifier(nil)
+
+		clientHello := []byte{0x16, 0x03, 0x01, 0x00, 0xa5, 0x01, 0x00, 0x00, 0xa1}
+		require.Equal(t, "TLS", c.Classify(packet("TCP", "50000", "8443"), clientHello))
+
+		require.Equal(t, "HTTP", c.Classify(packet("TCP", "50001", "9000"), []byte("GET / HTTP/1.1\r\n")))
+		require.Equal(t, "SSH", c.Classify(packet("TCP", "50002", "2222"), []byte("SSH-2.0-OpenSSH_9.6\r\n")))
+		require.Equal(t, "Redis", c.Classify(packet("TCP", "50003", "7000"), []byte("*1\r\n$4\r\nPING\r\n")))
+
+		startup := make([]byte, 16)
+		binary.BigEndian.PutUint32(startup[0:4], 16)
+		binary.BigEndian.PutUint32(startup[4:8], pgProtocolVersion3)
+		require.Equal(t, "PostgreSQL", c.Classify(packet("TCP", "50004", "6432"), startup))
+
+		query := []byte{
+			0x12, 0x34, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x07, 'e', 'x', 'a', 'm', 'p', 'l', 'e', 0x03, 'c', 'o', 'm', 0x00, 0x00, 0x01, 0x00, 0x01,
+		}
+		require.Equal(t, "DNS", c.Classify(packet("UDP", "40000", "5300"), query))
+	})
+
+	t.Run("flow inherits payload classification", func(t *testing.T) {
+		c := NewClassifier(nil)
+		clientHello := []byte{0x16, 0x03, 0x01, 0x00, 0xa5, 0x01, 0x00, 0x00, 0xa1}
+		require.Equal(t, "TLS", c.Classify(packet("TCP", "50000", "8443"), clientHello))
+
+		reply := &PacketInfo{
+			Protocol: "TCP", SourceIP: "10.0.0.2", SourcePort: "8443", DestinationIP: "10.0.0.1", DestinationPort: "50000",
+		}
+		require.Equal(t, "TLS", c.Classify(reply, []byte{0x00, 0x01, 0x02}))
+	})
+
+	t.Run("classify by ports", func(t *testing.T) {
+		c := NewClassifier([]config.Service{
+			{Name: "Custom", Protocol: "tcp", Port: 9999},
+			{Name: "HTTPS", Protocol: "tcp", Port: 443},
+		})
+
+		require.Equal(t, "PostgreSQL", c.Classify(packet("TCP", "5432", "40000"), nil))
+		require.Equal(t, "Custom", c.Classify(packet("TCP", "40001", "9999"), nil))
+		require.Equal(t, "HTTPS", c.Classify(packet("TCP", "40002", "443"), nil))
+		require.Equal(t, "DNS", c.Classify(packet("UDP", "53", "53"), nil))
+		require.Equal(t, ApplicationUnknown, c.Classify(packet("TCP", "40003", "40004"), nil))
+		require.Equal(t, ApplicationUnknown, c.Classify(packet("UDP", "40003", "5432"), nil))
+	})
+
+	t.Run("packets without transport layer", func(t *testing.T) {
+		c := NewClassifier(nil)
+		require.Equal(t, "", c.Classify(&PacketInfo{Protocol: "ARP"}, nil))
+	})
+}
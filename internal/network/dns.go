@@ -0,0 +1,175 @@
+package network
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	DNSNoError  = "NOERROR"
+	DNSNXDomain = "NXDOMAIN"
+	DNSServFail = "SERVFAIL"
+)
+
+var dnsResponseCodes = map[uint8]string{
+	0: DNSNoError,
+	1: "FORMERR",
+	2: DNSServFail,
+	3: DNSNXDomain,
+	4: "NOTIMP",
+	5: "REFUSED",
+}
+
+// DNSMessage - сведения из заголовка и первого вопроса DNS-сообщения.
+type DNSMessage struct {
+	ID       uint16
+	Response bool
+	Name     string
+	Type     string
+	RCode    uint8
+}
+
+// ResponseCodeName возвращает мнемоническое имя кода ответа DNS.
+func ResponseCodeName(code uint8) string {
+	if name, ok := dnsResponseCodes[code]; ok {
+		return name
+	}
+	return "RCODE" + strconv.Itoa(int(code))
+}
+
+type DNSCounter struct {
+	Name  string
+	Count uint64
+}
+
+type DNSResolverStat struct {
+	Resolver   string
+	Queries    uint64
+	Responses  uint64
+	AvgLatency time.Duration
+	MaxLatency time.Duration
+}
+
+type DNSStat struct {
+	Queries       uint64
+	Responses     uint64
+	TopDomains    []DNSCounter
+	QueryTypes    []DNSCounter
+	ResponseCodes []DNSCounter
+	Resolvers     []DNSResolverStat
+}
+
+// RCodePercent возвращает долю ответов с указанным кодом.
+func (s *DNSStat) RCodePercent(code string) float64 {
+	if s.Responses == 0 {
+		return 0
+	}
+	for _, c := range s.ResponseCodes {
+		if c.Name == code {
+			return float64(c.Count) / float64(s.Responses) * 100.0
+		}
+	}
+	return 0
+}
+
+// Запрос и ответ сопоставляются по адресу клиента, адресу резолвера и идентификатору сообщения.
+type dnsQueryKey struct {
+	client     string
+	clientPort string
+	resolver   string
+	id         uint16
+}
+
+func sortedCounters(m map[string]uint64, limit int) []DNSCounter {
+	result := make([]DNSCounter, 0, len(m))
+	for k, v := range m {
+		result = append(result, DNSCounter{Name: k, Count: v})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count == result[j].Count {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Count > result[j].Count
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// CalcDNSStat строит статистику DNS по захваченным пакетам. Пакеты должны идти в порядке захвата,
+// topDomains ограничивает количество доменов в отчете.
+func CalcDNSStat(packets []PacketInfo, topDomains int) *DNSStat {
+	domains := make(map[string]uint64)
+	types := make(map[string]uint64)
+	codes := make(map[string]uint64)
+	resolvers := make(map[string]*DNSResolverStat)
+	latency := make(map[string]time.Duration)
+	matched := make(map[string]int64)
+	pending := make(map[dnsQueryKey]time.Time)
+	result := &DNSStat{}
+
+	resolver := func(ip string) *DNSResolverStat {
+		if r, ok := resolvers[ip]; ok {
+			return r
+		}
+		r := &DNSResolverStat{Resolver: ip}
+		resolvers[ip] = r
+		return r
+	}
+
+	for _, p := range packets {
+		if p.DNS == nil {
+			continue
+		}
+		m := p.DNS
+		if !m.Response {
+			result.Queries++
+			if m.Name != "" {
+				domains[m.Name]++
+			}
+			if m.Type != "" {
+				types[m.Type]++
+			}
+			resolver(p.DestinationIP).Queries++
+			pending[dnsQueryKey{p.SourceIP, p.SourcePort, p.DestinationIP, m.ID}] = p.Timestamp
+			continue
+		}
+
+		result.Responses++
+		codes[ResponseCodeName(m.RCode)]++
+		r := resolver(p.SourceIP)
+		r.Responses++
+		key := dnsQueryKey{p.DestinationIP, p.DestinationPort, p.SourceIP, m.ID}
+		sent, ok := pending[key]
+		if !ok {
+			continue
+		}
+		delete(pending, key)
+		d := p.Timestamp.Sub(sent)
+		if d < 0 {
+			continue
+		}
+		matched[r.Resolver]++
+		latency[r.Resolver] += d
+		if d > r.MaxLatency {
+			r.MaxLatency = d
+		}
+	}
+
+	result.TopDomains = sortedCounters(domains, topDomains)
+	result.QueryTypes = sortedCounters(types, 0)
+	result.ResponseCodes = sortedCounters(codes, 0)
+
+	for _, r := range resolvers {
+		if n := matched[r.Resolver]; n > 0 {
+			r.AvgLatency = latency[r.Resolver] / time.Duration(n)
+		}
+		result.Resolvers = append(result.Resolvers, *r)
+	}
+	sort.Slice(result.Resolvers, func(i, j int) bool {
+		return result.Resolvers[i].Resolver < result.Resolvers[j].Resolver
+	})
+	return result
+}
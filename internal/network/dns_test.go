@@ -0,0 +1,80 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func dnsPacket(src, srcPort, dst, dstPort string, ts time.Time, m *DNSMessage) PacketInfo {
+	return PacketInfo{
+		Protocol: "UDP", SourceIP: src, SourcePort: srcPort,
+		DestinationIP: dst, DestinationPort: dstPort, Timestamp: ts, DNS: m,
+	}
+}
+
+func TestCalcDNSStat(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	query := func(id uint16, name, qtype string) *DNSMessage {
+		return &DNSMessage{ID: id, Name: name, Type: qtype}
+	}
+	response := func(id uint16, rcode uint8) *DNSMessage {
+		return &DNSMessage{ID: id, Response: true, RCode: rcode}
+	}
+
+	packets := []PacketInfo{
+		dnsPacket("10.0.0.2", "40000", "8.8.8.8", "53", at(0), query(1, "example.com", "A")),
+		dnsPacket("10.0.0.2", "40001", "8.8.8.8", "53", at(5), query(2, "example.com", "AAAA")),
+		dnsPacket("10.0.0.2", "40002", "1.1.1.1", "53", at(10), query(3, "missing.test", "A")),
+		{Protocol: "TCP", SourceIP: "10.0.0.2", DestinationIP: "10.0.0.3"},
+		dnsPacket("8.8.8.8", "53", "10.0.0.2", "40000", at(20), response(1, 0)),
+		dnsPacket("8.8.8.8", "53", "10.0.0.2", "40001", at(45), response(2, 2)),
+		dnsPacket("1.1.1.1", "53", "10.0.0.2", "40002", at(40), response(3, 3)),
+		// Ответ без запроса учитывается в кодах, но не в задержке
+		dnsPacket("1.1.1.1", "53", "10.0.0.2", "40003", at(50), response(9, 0)),
+	}
+
+	t.Run("counters", func(t *testing.T) {
+		stat := CalcDNSStat(packets, 0)
+		require.Equal(t, uint64(3), stat.Queries)
+		require.Equal(t, uint64(4), stat.Responses)
+		require.Equal(t, []DNSCounter{{"example.com", 2}, {"missing.test", 1}}, stat.TopDomains)
+		require.Equal(t, []DNSCounter{{"A", 2}, {"AAAA", 1}}, stat.QueryTypes)
+		require.Equal(t, []DNSCounter{{DNSNoError, 2}, {DNSNXDomain, 1}, {DNSServFail, 1}}, stat.ResponseCodes)
+		require.InDelta(t, 25.0, stat.RCodePercent(DNSNXDomain), 0.001)
+		require.InDelta(t, 25.0, stat.RCodePercent(DNSServFail), 0.001)
+	})
+
+	t.Run("latency per resolver", func(t *testing.T) {
+		stat := CalcDNSStat(packets, 0)
+		require.Equal(t, []DNSResolverStat{
+			{
+				Resolver: "1.1.1.1", Queries: 1, Responses: 2,
+				AvgLatency: 30 * time.Millisecond, MaxLatency: 30 * time.Millisecond,
+			},
+			{
+				Resolver: "8.8.8.8", Queries: 2, Responses: 2,
+				AvgLatency: 30 * time.Millisecond, MaxLatency: 40 * time.Millisecond,
+			},
+		}, stat.Resolvers)
+	})
+
+	t.Run("top domains limit", func(t *testing.T) {
+		stat := CalcDNSStat(packets, 1)
+		require.Equal(t, []DNSCounter{{"example.com", 2}}, stat.TopDomains)
+	})
+
+	t.Run("no dns traffic", func(t *testing.T) {
+		stat := CalcDNSStat(nil, 10)
+		require.Zero(t, stat.Queries)
+		require.Empty(t, stat.Resolvers)
+		require.Zero(t, stat.RCodePercent(DNSNXDomain))
+	})
+
+	t.Run("response code names", func(t *testing.T) {
+		require.Equal(t, "REFUSED", ResponseCodeName(5))
+		require.Equal(t, "RCODE11", ResponseCodeName(11))
+	})
+}
@@ -206,13 +206,18 @@ func (l *LinuxConnectionsCollector) extractProcInfo(connections ConnectionsStat)
 	}
 }
 
-func (l *LinuxConnectionsCollector) netstat(path string, protocol string) ([]Connection, error) {
+// ReadConnections читает таблицу сокетов протокола без сведений о процессах.
+func ReadConnections(path string, protocol string) ([]Connection, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = f.Close() }()
-	connections, err := parseConnectionsFile(f, protocol)
+	return parseConnectionsFile(f, protocol)
+}
+
+func (l *LinuxConnectionsCollector) netstat(path string, protocol string) ([]Connection, error) {
+	connections, err := ReadConnections(path, protocol)
 	if err != nil {
 		return nil, err
 	}
@@ -302,10 +307,11 @@ func (l *LinuxConnectionsCollector) Run() (<-chan ConnectionsStat, error) {
 	if _, err := l.GetConnection(); err != nil {
 		l.cfg.Metrics.NetConnections = false
 		l.cfg.Metrics.NetConnectionsStates = false
+		l.cfg.Metrics.NetTopByProcess = false
 		return nil, err
 	}
 	ch := make(chan ConnectionsStat)
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorNetConnections))
 
 	go func() {
 		defer close(ch)
@@ -316,7 +322,7 @@ func (l *LinuxConnectionsCollector) Run() (<-chan ConnectionsStat, error) {
 				l.l.Debug("connections collector stopped")
 				return
 			case <-ticker.C:
-				if !l.cfg.Metrics.NetConnections && !l.cfg.Metrics.NetConnectionsStates {
+				if !l.cfg.Metrics.NetConnections && !l.cfg.Metrics.NetConnectionsStates && !l.cfg.Metrics.NetTopByProcess {
 					continue
 				}
 				stat, err := l.GetConnection()
@@ -324,6 +330,7 @@ func (l *LinuxConnectionsCollector) Run() (<-chan ConnectionsStat, error) {
 					l.l.Error("connections collector error", "error", err.Error())
 					l.cfg.Metrics.NetConnections = false
 					l.cfg.Metrics.NetConnectionsStates = false
+					l.cfg.Metrics.NetTopByProcess = false
 					return
 				}
 				ch <- stat
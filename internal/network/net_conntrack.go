@@ -0,0 +1,86 @@
+package network
+
+import (
+	"sort"
+	"time"
+)
+
+// ConntrackEntries - число записей таблицы conntrack протокола Protocol в состоянии State.
+// У протоколов без состояний (udp, icmp) State пустой.
+type ConntrackEntries struct {
+	Protocol string
+	State    string
+	Count    uint64
+}
+
+// ConntrackStat - заполнение таблицы отслеживания соединений. Счетчики из /proc/net/stat/nf_conntrack
+// просуммированы по процессорам и приведены к скорости в секунду.
+type ConntrackStat struct {
+	Count        uint64
+	Max          uint64
+	FillPercent  float64
+	InsertFailed float64
+	Drop         float64
+	EarlyDrop    float64
+	Invalid      float64
+	Entries      []ConntrackEntries
+}
+
+type ConntrackCollector interface {
+	Run() (<-chan *ConntrackStat, error)
+	Get() (*ConntrackStat, error)
+}
+
+type conntrackCounters struct {
+	time     time.Time
+	count    uint64
+	max      uint64
+	counters map[string]uint64
+	entries  map[ConntrackEntries]uint64
+}
+
+var conntrackRates = []struct {
+	key   string
+	field func(s *ConntrackStat) *float64
+}{
+	{"insert_failed", func(s *ConntrackStat) *float64 { return &s.InsertFailed }},
+	{"drop", func(s *ConntrackStat) *float64 { return &s.Drop }},
+	{"early_drop", func(s *ConntrackStat) *float64 { return &s.EarlyDrop }},
+	{"invalid", func(s *ConntrackStat) *float64 { return &s.Invalid }},
+}
+
+// calcConntrackStat считает заполнение таблицы и скорости счетчиков между двумя снимками.
+// Без предыдущего снимка скорости нулевые. Записи отсортированы по убыванию числа.
+func calcConntrackStat(prev, cur *conntrackCounters) *ConntrackStat {
+	result := &ConntrackStat{Count: cur.count, Max: cur.max}
+	if cur.max > 0 {
+		result.FillPercent = float64(cur.count) / float64(cur.max) * 100
+	}
+	for k, v := range cur.entries {
+		result.Entries = append(result.Entries, ConntrackEntries{Protocol: k.Protocol, State: k.State, Count: v})
+	}
+	sort.Slice(result.Entries, func(i, j int) bool {
+		a, b := result.Entries[i], result.Entries[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		return a.State < b.State
+	})
+	if prev == nil {
+		return result
+	}
+	seconds := cur.time.Sub(prev.time).Seconds()
+	if seconds <= 0 {
+		return result
+	}
+	for _, r := range conntrackRates {
+		// Уменьшение счетчика означает его сброс, такой интервал пропускаем
+		if c, p := cur.counters[r.key], prev.counters[r.key]; c >= p {
+			*r.field(result) = float64(c-p) / seconds
+		}
+	}
+	return result
+}
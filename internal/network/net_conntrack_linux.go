@@ -0,0 +1,166 @@
+//go:build linux
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+var ErrNoConntrack = errors.New("nf_conntrack module is not loaded")
+
+type LinuxConntrackCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	prev      *conntrackCounters
+}
+
+func NewLinuxConntrackCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxConntrackCollector {
+	return &LinuxConntrackCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxConntrackCollector) Run() (<-chan *ConntrackStat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("conntrack collector error", "error", err.Error())
+		l.cfg.Metrics.Conntrack = false
+		return nil, err
+	}
+	ch := make(chan *ConntrackStat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorConntrack))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("conntrack collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.Conntrack {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("conntrack collector error", "error", err.Error())
+					l.cfg.Metrics.Conntrack = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает заполнение таблицы conntrack и скорости счетчиков с момента предыдущего вызова.
+// Разбивка по протоколам и состояниям собирается, только если она включена в настройках:
+// чтение /proc/net/nf_conntrack на заполненной таблице обходится дорого.
+func (l *LinuxConntrackCollector) Get() (*ConntrackStat, error) {
+	cur, err := l.readCounters()
+	if err != nil {
+		return nil, err
+	}
+	stat := calcConntrackStat(l.prev, cur)
+	l.prev = cur
+	return stat, nil
+}
+
+func (l *LinuxConntrackCollector) readCounters() (*conntrackCounters, error) {
+	root := filepath.Join(l.cfg.System.Proc, "sys", "net", "netfilter")
+	count, err := readUint(filepath.Join(root, "nf_conntrack_count"))
+	if err != nil {
+		return nil, ErrNoConntrack
+	}
+	maxCount, err := readUint(filepath.Join(root, "nf_conntrack_max"))
+	if err != nil {
+		return nil, ErrNoConntrack
+	}
+	result := &conntrackCounters{
+		time:     time.Now(),
+		count:    count,
+		max:      maxCount,
+		counters: make(map[string]uint64),
+	}
+	if lines, err := utils.ReadLines(filepath.Join(l.cfg.System.Proc, "net", "stat", "nf_conntrack")); err == nil {
+		parseConntrackStat(lines, result.counters)
+	}
+	if l.cfg.Conntrack.Entries {
+		if f, err := os.Open(filepath.Join(l.cfg.System.Proc, "net", "nf_conntrack")); err == nil {
+			result.entries = parseConntrackEntries(f)
+			f.Close()
+		}
+	}
+	return result, nil
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// parseConntrackStat разбирает /proc/net/stat/nf_conntrack: строка с именами счетчиков
+// и по строке шестнадцатеричных значений на каждый процессор. Набор столбцов зависит от версии ядра.
+func parseConntrackStat(lines []string, result map[string]uint64) {
+	if len(lines) == 0 {
+		return
+	}
+	names := strings.Fields(lines[0])
+	for _, line := range lines[1:] {
+		values := strings.Fields(line)
+		if len(values) != len(names) {
+			continue
+		}
+		for i, name := range names {
+			// entries - общее число записей, одинаковое в строках всех процессоров
+			if name == "entries" {
+				continue
+			}
+			if v, err := strconv.ParseUint(values[i], 16, 64); err == nil {
+				result[name] += v
+			}
+		}
+	}
+}
+
+// parseConntrackEntries считает записи /proc/net/nf_conntrack по протоколам и состояниям. Строка имеет вид
+// "ipv4 2 tcp 6 431999 ESTABLISHED src=...", у протоколов без состояний за таймаутом сразу идут адреса.
+func parseConntrackEntries(r io.Reader) map[ConntrackEntries]uint64 {
+	result := make(map[ConntrackEntries]uint64)
+	br := bufio.NewScanner(r)
+	for br.Scan() {
+		fields := strings.Fields(br.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		key := ConntrackEntries{Protocol: fields[2]}
+		if len(fields) > 5 && !strings.Contains(fields[5], "=") {
+			key.State = fields[5]
+		}
+		result[key]++
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+//go:build linux
+
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+// Формат ядер до 5.x, в новых ядрах набор столбцов шире.
+const (
+	fakeConntrackStat = `entries  searched found new invalid ignore delete delete_list insert insert_failed drop early_drop
+00000064  00000000 00000000 00000000 00000002 00000000 00000000 00000000 00000000 0000000a 00000001 00000000
+00000064  00000000 00000000 00000000 00000003 00000000 00000000 00000000 00000000 00000005 00000001 00000010`
+	fakeConntrackTable = `ipv4 2 tcp 6 431999 ESTABLISHED src=10.0.0.1 dst=10.0.0.2 sport=5000 dport=22 [ASSURED] use=2
+ipv4 2 tcp 6 102 TIME_WAIT src=10.0.0.1 dst=10.0.0.3 sport=5001 dport=80 [ASSURED] use=2
+ipv4 2 tcp 6 431999 ESTABLISHED src=10.0.0.1 dst=10.0.0.4 sport=5002 dport=443 [ASSURED] use=2
+ipv4 2 udp 17 29 src=10.0.0.1 dst=8.8.8.8 sport=5353 dport=53 use=2
+ipv6 10 icmpv6 58 29 src=fe80::1 dst=ff02::2 type=133 code=0 id=0 [UNREPLIED] use=2`
+)
+
+func fakeConntrackProc(t *testing.T, count, maxCount string) string {
+	t.Helper()
+	root := t.TempDir()
+	netfilter := filepath.Join(root, "sys", "net", "netfilter")
+	require.NoError(t, os.MkdirAll(netfilter, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "net", "stat"), 0o755))
+	files := map[string]string{
+		filepath.Join(netfilter, "nf_conntrack_count"):     count,
+		filepath.Join(netfilter, "nf_conntrack_max"):       maxCount,
+		filepath.Join(root, "net", "stat", "nf_conntrack"): fakeConntrackStat,
+		filepath.Join(root, "net", "nf_conntrack"):         fakeConntrackTable,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(name, []byte(content+"\n"), 0o600))
+	}
+	return root
+}
+
+func conntrackConfig(proc string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Proc: proc},
+		Metrics: config.Metrics{Conntrack: true},
+	}
+}
+
+func TestConntrack(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("conntrack: stat parsing", func(t *testing.T) {
+		result := make(map[string]uint64)
+		parseConntrackStat(strings.Split(fakeConntrackStat, "\n"), result)
+		require.Equal(t, uint64(15), result["insert_failed"])
+		require.Equal(t, uint64(2), result["drop"])
+		require.Equal(t, uint64(16), result["early_drop"])
+		require.Equal(t, uint64(5), result["invalid"])
+		require.NotContains(t, result, "entries")
+	})
+
+	t.Run("conntrack: entries parsing", func(t *testing.T) {
+		require.Equal(t, map[ConntrackEntries]uint64{
+			{Protocol: "tcp", State: "ESTABLISHED"}: 2,
+			{Protocol: "tcp", State: "TIME_WAIT"}:   1,
+			{Protocol: "udp"}:                       1,
+			{Protocol: "icmpv6"}:                    1,
+		}, parseConntrackEntries(strings.NewReader(fakeConntrackTable)))
+	})
+
+	t.Run("conntrack: rates", func(t *testing.T) {
+		now := time.Now()
+		prev := &conntrackCounters{
+			time:     now,
+			counters: map[string]uint64{"insert_failed": 10, "drop": 4, "early_drop": 0, "invalid": 100},
+		}
+		cur := &conntrackCounters{
+			time:     now.Add(2 * time.Second),
+			count:    768,
+			max:      1024,
+			counters: map[string]uint64{"insert_failed": 30, "drop": 4, "early_drop": 6, "invalid": 1},
+			entries: map[ConntrackEntries]uint64{
+				{Protocol: "udp"}: 2, {Protocol: "tcp", State: "ESTABLISHED"}: 5, {Protocol: "icmp"}: 2,
+			},
+		}
+		stat := calcConntrackStat(prev, cur)
+		require.InDelta(t, 75.0, stat.FillPercent, 1e-9)
+		require.InDelta(t, 10.0, stat.InsertFailed, 1e-9)
+		require.Zero(t, stat.Drop)
+		require.InDelta(t, 3.0, stat.EarlyDrop, 1e-9)
+		// Счетчик сброшен, скорость за интервал не считается
+		require.Zero(t, stat.Invalid)
+		require.Equal(t, []ConntrackEntries{
+			{Protocol: "tcp", State: "ESTABLISHED", Count: 5},
+			{Protocol: "icmp", Count: 2},
+			{Protocol: "udp", Count: 2},
+		}, stat.Entries)
+	})
+
+	t.Run("conntrack: Get()", func(t *testing.T) {
+		cfg := conntrackConfig(fakeConntrackProc(t, "5", "200"))
+		l := NewLinuxConntrackCollector(context.TODO(), context.TODO(), cfg, log)
+		stat, err := l.Get()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), stat.Count)
+		require.InDelta(t, 2.5, stat.FillPercent, 1e-9)
+		require.Zero(t, stat.InsertFailed)
+		// Разбивка по протоколам выключена по умолчанию
+		require.Empty(t, stat.Entries)
+
+		cfg.Conntrack.Entries = true
+		stat, err = l.Get()
+		require.NoError(t, err)
+		require.Len(t, stat.Entries, 4)
+	})
+
+	t.Run("conntrack: Run() error", func(t *testing.T) {
+		cfg := conntrackConfig(t.TempDir())
+		l := NewLinuxConntrackCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := l.Run()
+		require.Nil(t, ch)
+		require.ErrorIs(t, err, ErrNoConntrack)
+		require.False(t, cfg.Metrics.Conntrack)
+	})
+
+	t.Run("conntrack: metric enabled", func(t *testing.T) {
+		cfg := conntrackConfig(fakeConntrackProc(t, "5", "200"))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		l := NewLinuxConntrackCollector(ctx, ctx, cfg, log)
+
+		ch, err := l.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.NotNil(t, val)
+		require.Equal(t, uint64(200), val.Max)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.Conntrack)
+	})
+}
@@ -0,0 +1,132 @@
+package network
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// UnknownProcess - корзина для трафика, который не удалось сопоставить ни с одним сокетом.
+const UnknownProcess = "unknown"
+
+type ProcessTraffic struct {
+	Pid     int
+	Command string
+	User    string
+	RxBytes uint64
+	TxBytes uint64
+}
+
+type ProcessTrafficMap map[string]*ProcessTraffic
+
+// socketIndex - таблица сокетов, индексированная по протоколу и локальному адресу.
+type socketIndex map[string]*Connection
+
+func normalizeProtocol(protocol string) string {
+	return strings.TrimSuffix(strings.ToLower(protocol), "6")
+}
+
+func socketKey(protocol, ip, port string) string {
+	return normalizeProtocol(protocol) + " " + ip + ":" + port
+}
+
+func newSocketIndex(connections []Connection) socketIndex {
+	index := make(socketIndex, len(connections))
+	for i := range connections {
+		c := &connections[i]
+		if c.LocalAddress == nil {
+			continue
+		}
+		key := socketKey(c.Protocol, c.LocalAddress.IP.String(), strconv.Itoa(int(c.LocalAddress.Port)))
+		// Сокет с известным процессом приоритетнее
+		if existing, ok := index[key]; ok && existing.Process != nil {
+			continue
+		}
+		index[key] = c
+	}
+	return index
+}
+
+func (s socketIndex) lookup(protocol, ip, port string, localIPs map[string]struct{}) *Connection {
+	if port == "" {
+		return nil
+	}
+	if c, ok := s[socketKey(protocol, ip, port)]; ok {
+		return c
+	}
+	if _, ok := localIPs[ip]; !ok {
+		return nil
+	}
+	// Сокеты, слушающие на всех интерфейсах
+	for _, wildcard := range []string{net.IPv4zero.String(), net.IPv6unspecified.String()} {
+		if c, ok := s[socketKey(protocol, wildcard, port)]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+func (m ProcessTrafficMap) get(c *Connection) *ProcessTraffic {
+	key := UnknownProcess
+	if c != nil && c.Process != nil {
+		key = strconv.Itoa(c.Process.Pid)
+	}
+	if v, ok := m[key]; ok {
+		return v
+	}
+	v := &ProcessTraffic{Command: UnknownProcess, User: "-"}
+	if key != UnknownProcess {
+		v.Pid = c.Process.Pid
+		v.Command = c.Process.CmdLine
+		v.User = c.User
+	}
+	m[key] = v
+	return v
+}
+
+// LocalIPs возвращает адреса всех интерфейсов хоста.
+func LocalIPs() map[string]struct{} {
+	result := make(map[string]struct{})
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return result
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			result[ipNet.IP.String()] = struct{}{}
+		}
+	}
+	return result
+}
+
+// TrafficByProcess сопоставляет захваченные пакеты с таблицей сокетов и распределяет
+// трафик по процессам-владельцам. Пакеты, для которых сокет не найден, попадают в корзину UnknownProcess.
+func TrafficByProcess(
+	connections []Connection, packets []PacketInfo, localIPs map[string]struct{},
+) ProcessTrafficMap {
+	index := newSocketIndex(connections)
+	result := make(ProcessTrafficMap)
+
+	for _, p := range packets {
+		src := index.lookup(p.Protocol, p.SourceIP, p.SourcePort, localIPs)
+		dst := index.lookup(p.Protocol, p.DestinationIP, p.DestinationPort, localIPs)
+
+		if src != nil {
+			result.get(src).TxBytes += p.PayloadSize
+		}
+		if dst != nil {
+			result.get(dst).RxBytes += p.PayloadSize
+		}
+		if src != nil || dst != nil {
+			continue
+		}
+
+		unknown := result.get(nil)
+		if _, ok := localIPs[p.SourceIP]; ok {
+			unknown.TxBytes += p.PayloadSize
+		} else {
+			unknown.RxBytes += p.PayloadSize
+		}
+	}
+	return result
+}
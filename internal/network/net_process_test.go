@@ -0,0 +1,92 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrafficByProcess(t *testing.T) {
+	connections := []Connection{
+		{
+			SocketID:     "100",
+			Protocol:     ProtocolTCP,
+			Process:      &Process{Pid: 10, CmdLine: "/usr/sbin/nginx"},
+			User:         "www-data",
+			LocalAddress: &SockAddr{IP: net.ParseIP("0.0.0.0"), Port: 443},
+		},
+		{
+			SocketID:     "200",
+			Protocol:     ProtocolTCP,
+			Process:      &Process{Pid: 20, CmdLine: "/usr/bin/curl"},
+			User:         "root",
+			LocalAddress: &SockAddr{IP: net.ParseIP("192.168.1.10").To4(), Port: 40000},
+		},
+		{
+			SocketID:     "300",
+			Protocol:     ProtocolUDP6,
+			Process:      &Process{Pid: 30, CmdLine: "/usr/sbin/named"},
+			User:         "bind",
+			LocalAddress: &SockAddr{IP: net.ParseIP("::"), Port: 53},
+		},
+	}
+	localIPs := map[string]struct{}{"192.168.1.10": {}}
+
+	t.Run("packets are attributed to owning processes", func(t *testing.T) {
+		packets := []PacketInfo{
+			{
+				Protocol: "TCP", SourceIP: "10.0.0.1", SourcePort: "51000",
+				DestinationIP: "192.168.1.10", DestinationPort: "443", PayloadSize: 100,
+			},
+			{
+				Protocol: "TCP", SourceIP: "192.168.1.10", SourcePort: "443",
+				DestinationIP: "10.0.0.1", DestinationPort: "51000", PayloadSize: 1000,
+			},
+			{
+				Protocol: "TCP", SourceIP: "192.168.1.10", SourcePort: "40000",
+				DestinationIP: "1.1.1.1", DestinationPort: "80", PayloadSize: 50,
+			},
+			{
+				Protocol: "UDP", SourceIP: "10.0.0.2", SourcePort: "33333",
+				DestinationIP: "192.168.1.10", DestinationPort: "53", PayloadSize: 70,
+			},
+		}
+
+		stat := TrafficByProcess(connections, packets, localIPs)
+		require.Len(t, stat, 3)
+
+		require.Equal(t, "/usr/sbin/nginx", stat["10"].Command)
+		require.Equal(t, "www-data", stat["10"].User)
+		require.Equal(t, uint64(100), stat["10"].RxBytes)
+		require.Equal(t, uint64(1000), stat["10"].TxBytes)
+
+		require.Equal(t, uint64(0), stat["20"].RxBytes)
+		require.Equal(t, uint64(50), stat["20"].TxBytes)
+
+		require.Equal(t, uint64(70), stat["30"].RxBytes)
+	})
+
+	t.Run("unmatched traffic goes to unknown bucket", func(t *testing.T) {
+		packets := []PacketInfo{
+			{
+				Protocol: "TCP", SourceIP: "192.168.1.10", SourcePort: "60000",
+				DestinationIP: "1.1.1.1", DestinationPort: "443", PayloadSize: 10,
+			},
+			{
+				Protocol: "TCP", SourceIP: "1.1.1.1", SourcePort: "443",
+				DestinationIP: "192.168.1.10", DestinationPort: "60000", PayloadSize: 20,
+			},
+			{Protocol: "ARP", SourceIP: "192.168.1.1", DestinationIP: "192.168.1.10", PayloadSize: 5},
+		}
+
+		stat := TrafficByProcess(connections, packets, localIPs)
+		require.Len(t, stat, 1)
+		unknown := stat[UnknownProcess]
+		require.NotNil(t, unknown)
+		require.Equal(t, 0, unknown.Pid)
+		require.Equal(t, UnknownProcess, unknown.Command)
+		require.Equal(t, uint64(10), unknown.TxBytes)
+		require.Equal(t, uint64(25), unknown.RxBytes)
+	})
+}
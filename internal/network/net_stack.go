@@ -0,0 +1,97 @@
+package network
+
+import "time"
+
+// NetStackStat - состояние сетевого стека ядра. Счетчики ошибок приведены к скорости в секунду,
+// счетчики UDP и ICMP включают IPv6. Статистика сокетов - текущие значения из sockstat.
+type NetStackStat struct {
+	TCPRetransSegs  float64
+	TCPOutRsts      float64
+	TCPEstabResets  float64
+	TCPAttemptFails float64
+	TCPInErrs       float64
+	ListenOverflows float64
+	ListenDrops     float64
+	UDPRcvbufErrors float64
+	UDPSndbufErrors float64
+	UDPInErrors     float64
+	ICMPInErrors    float64
+	ICMPOutErrors   float64
+
+	SocketsUsed uint64
+	TCPInUse    uint64
+	TCPOrphan   uint64
+	TCPTimeWait uint64
+	TCPAlloc    uint64
+	TCPMemBytes uint64
+	UDPInUse    uint64
+	UDPMemBytes uint64
+	TCP6InUse   uint64
+	UDP6InUse   uint64
+}
+
+type NetStackCollector interface {
+	Run() (<-chan *NetStackStat, error)
+	Get() (*NetStackStat, error)
+}
+
+// netStackCounters - снимок накопительных счетчиков. Ключи имеют вид "<раздел>.<счетчик>",
+// например Tcp.RetransSegs или TCP.inuse для sockstat.
+type netStackCounters struct {
+	time     time.Time
+	counters map[string]uint64
+	sockstat map[string]uint64
+}
+
+var netStackRates = []struct {
+	keys  []string
+	field func(s *NetStackStat) *float64
+}{
+	{[]string{"Tcp.RetransSegs"}, func(s *NetStackStat) *float64 { return &s.TCPRetransSegs }},
+	{[]string{"Tcp.OutRsts"}, func(s *NetStackStat) *float64 { return &s.TCPOutRsts }},
+	{[]string{"Tcp.EstabResets"}, func(s *NetStackStat) *float64 { return &s.TCPEstabResets }},
+	{[]string{"Tcp.AttemptFails"}, func(s *NetStackStat) *float64 { return &s.TCPAttemptFails }},
+	{[]string{"Tcp.InErrs"}, func(s *NetStackStat) *float64 { return &s.TCPInErrs }},
+	{[]string{"TcpExt.ListenOverflows"}, func(s *NetStackStat) *float64 { return &s.ListenOverflows }},
+	{[]string{"TcpExt.ListenDrops"}, func(s *NetStackStat) *float64 { return &s.ListenDrops }},
+	{[]string{"Udp.RcvbufErrors", "Udp6.RcvbufErrors"}, func(s *NetStackStat) *float64 { return &s.UDPRcvbufErrors }},
+	{[]string{"Udp.SndbufErrors", "Udp6.SndbufErrors"}, func(s *NetStackStat) *float64 { return &s.UDPSndbufErrors }},
+	{[]string{"Udp.InErrors", "Udp6.InErrors"}, func(s *NetStackStat) *float64 { return &s.UDPInErrors }},
+	{[]string{"Icmp.InErrors", "Icmp6.InErrors"}, func(s *NetStackStat) *float64 { return &s.ICMPInErrors }},
+	{[]string{"Icmp.OutErrors", "Icmp6.OutErrors"}, func(s *NetStackStat) *float64 { return &s.ICMPOutErrors }},
+}
+
+// calcNetStackStat считает скорости изменения счетчиков между двумя снимками.
+// Без предыдущего снимка скорости нулевые.
+func calcNetStackStat(prev, cur *netStackCounters, pageSize uint64) *NetStackStat {
+	result := &NetStackStat{
+		SocketsUsed: cur.sockstat["sockets.used"],
+		TCPInUse:    cur.sockstat["TCP.inuse"],
+		TCPOrphan:   cur.sockstat["TCP.orphan"],
+		TCPTimeWait: cur.sockstat["TCP.tw"],
+		TCPAlloc:    cur.sockstat["TCP.alloc"],
+		TCPMemBytes: cur.sockstat["TCP.mem"] * pageSize,
+		UDPInUse:    cur.sockstat["UDP.inuse"],
+		UDPMemBytes: cur.sockstat["UDP.mem"] * pageSize,
+		TCP6InUse:   cur.sockstat["TCP6.inuse"],
+		UDP6InUse:   cur.sockstat["UDP6.inuse"],
+	}
+	if prev == nil {
+		return result
+	}
+	seconds := cur.time.Sub(prev.time).Seconds()
+	if seconds <= 0 {
+		return result
+	}
+	for _, r := range netStackRates {
+		delta := uint64(0)
+		for _, key := range r.keys {
+			// Уменьшение счетчика означает его сброс, такой интервал пропускаем
+			if c, p := cur.counters[key], prev.counters[key]; c >= p {
+				delta += c - p
+			}
+		}
+		*r.field(result) = float64(delta) / seconds
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+//go:build linux
+
+package network
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+var ErrNoNetStackStat = errors.New("no network stack statistics found")
+
+// Префиксы разделов /proc/net/snmp6, в котором имя раздела не отделено от имени счетчика.
+var snmp6Sections = []string{"Ip6", "Icmp6", "UdpLite6", "Udp6"}
+
+type LinuxNetStackCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	prev      *netStackCounters
+}
+
+func NewLinuxNetStackCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxNetStackCollector {
+	return &LinuxNetStackCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxNetStackCollector) Run() (<-chan *NetStackStat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("network stack collector error", "error", err.Error())
+		l.cfg.Metrics.NetStack = false
+		return nil, err
+	}
+	ch := make(chan *NetStackStat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorNetStack))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("network stack collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.NetStack {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("network stack collector error", "error", err.Error())
+					l.cfg.Metrics.NetStack = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает скорости изменения счетчиков с момента предыдущего вызова.
+func (l *LinuxNetStackCollector) Get() (*NetStackStat, error) {
+	cur, err := l.readCounters()
+	if err != nil {
+		return nil, err
+	}
+	stat := calcNetStackStat(l.prev, cur, uint64(os.Getpagesize()))
+	l.prev = cur
+	return stat, nil
+}
+
+func (l *LinuxNetStackCollector) readCounters() (*netStackCounters, error) {
+	result := &netStackCounters{
+		time:     time.Now(),
+		counters: make(map[string]uint64),
+		sockstat: make(map[string]uint64),
+	}
+	root := filepath.Join(l.cfg.System.Proc, "net")
+	found := false
+
+	for _, name := range []string{"snmp", "netstat"} {
+		lines, err := utils.ReadLines(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		found = true
+		parseNetstatTable(lines, result.counters)
+	}
+	if lines, err := utils.ReadLines(filepath.Join(root, "snmp6")); err == nil {
+		found = true
+		parseSnmp6(lines, result.counters)
+	}
+	for _, name := range []string{"sockstat", "sockstat6"} {
+		lines, err := utils.ReadLines(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		found = true
+		parseSockstat(lines, result.sockstat)
+	}
+
+	if !found {
+		return nil, ErrNoNetStackStat
+	}
+	return result, nil
+}
+
+// parseNetstatTable разбирает формат /proc/net/snmp и /proc/net/netstat:
+// строка с именами счетчиков, за которой следует строка значений с тем же префиксом.
+func parseNetstatTable(lines []string, result map[string]uint64) {
+	for i := 0; i+1 < len(lines); i += 2 {
+		names := strings.Fields(lines[i])
+		values := strings.Fields(lines[i+1])
+		if len(names) == 0 || len(names) != len(values) || names[0] != values[0] {
+			continue
+		}
+		section := strings.TrimSuffix(names[0], ":")
+		for j := 1; j < len(names); j++ {
+			// Некоторые счетчики (например, Tcp.MaxConn) могут быть отрицательными, их пропускаем
+			if v, err := strconv.ParseUint(values[j], 10, 64); err == nil {
+				result[section+"."+names[j]] = v
+			}
+		}
+	}
+}
+
+func parseSnmp6(lines []string, result map[string]uint64) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		for _, section := range snmp6Sections {
+			if strings.HasPrefix(fields[0], section) {
+				result[section+"."+strings.TrimPrefix(fields[0], section)] = v
+				break
+			}
+		}
+	}
+}
+
+// parseSockstat разбирает строки вида "TCP: inuse 5 orphan 0 tw 2 alloc 7 mem 1".
+func parseSockstat(lines []string, result map[string]uint64) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		section := strings.TrimSuffix(fields[0], ":")
+		for j := 1; j+1 < len(fields); j += 2 {
+			if v, err := strconv.ParseUint(fields[j+1], 10, 64); err == nil {
+				result[section+"."+fields[j]] = v
+			}
+		}
+	}
+}
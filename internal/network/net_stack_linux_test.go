@@ -0,0 +1,151 @@
+//go:build linux
+
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+const (
+	fakeSnmp = `Ip: Forwarding DefaultTTL
+Ip: 1 64
+Icmp: InMsgs InErrors OutMsgs OutErrors
+Icmp: 10 2 10 1
+Tcp: RtoAlgorithm MaxConn ActiveOpens AttemptFails EstabResets InErrs OutRsts RetransSegs
+Tcp: 1 -1 100 3 4 5 6 70
+Udp: InDatagrams InErrors RcvbufErrors SndbufErrors
+Udp: 1000 8 7 1`
+	fakeNetstat = `TcpExt: SyncookiesSent ListenOverflows ListenDrops
+TcpExt: 0 11 12
+IpExt: InNoRoutes InOctets
+IpExt: 0 123456`
+	fakeSnmp6 = `Ip6InReceives 100
+Icmp6InErrors 3
+Icmp6OutErrors 0
+Udp6InErrors 2
+Udp6RcvbufErrors 1
+Udp6SndbufErrors 0
+UdpLite6InErrors 9`
+	fakeSockstat = `sockets: used 250
+TCP: inuse 10 orphan 1 tw 4 alloc 12 mem 3
+UDP: inuse 5 mem 2
+UDPLITE: inuse 0`
+	fakeSockstat6 = `TCP6: inuse 6
+UDP6: inuse 2`
+)
+
+func fakeNetProc(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "net"), 0o755))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(root, "net", name), []byte(content+"\n"), 0o600))
+	}
+	return root
+}
+
+func netStackConfig(proc string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Proc: proc},
+		Metrics: config.Metrics{NetStack: true},
+	}
+}
+
+func TestNetStack(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	allFiles := map[string]string{
+		"snmp": fakeSnmp, "netstat": fakeNetstat, "snmp6": fakeSnmp6,
+		"sockstat": fakeSockstat, "sockstat6": fakeSockstat6,
+	}
+
+	t.Run("net stack: counters parsing", func(t *testing.T) {
+		l := NewLinuxNetStackCollector(context.TODO(), context.TODO(), netStackConfig(fakeNetProc(t, allFiles)), log)
+		c, err := l.readCounters()
+		require.NoError(t, err)
+		require.Equal(t, uint64(70), c.counters["Tcp.RetransSegs"])
+		require.NotContains(t, c.counters, "Tcp.MaxConn")
+		require.Equal(t, uint64(12), c.counters["TcpExt.ListenDrops"])
+		require.Equal(t, uint64(123456), c.counters["IpExt.InOctets"])
+		require.Equal(t, uint64(1), c.counters["Udp6.RcvbufErrors"])
+		require.Equal(t, uint64(9), c.counters["UdpLite6.InErrors"])
+		require.Equal(t, uint64(3), c.counters["Icmp6.InErrors"])
+		require.Equal(t, uint64(250), c.sockstat["sockets.used"])
+		require.Equal(t, uint64(4), c.sockstat["TCP.tw"])
+		require.Equal(t, uint64(6), c.sockstat["TCP6.inuse"])
+	})
+
+	t.Run("net stack: first Get() has zero rates", func(t *testing.T) {
+		l := NewLinuxNetStackCollector(context.TODO(), context.TODO(), netStackConfig(fakeNetProc(t, allFiles)), log)
+		stat, err := l.Get()
+		require.NoError(t, err)
+		require.Zero(t, stat.TCPRetransSegs)
+		require.Equal(t, uint64(250), stat.SocketsUsed)
+		require.Equal(t, uint64(3*os.Getpagesize()), stat.TCPMemBytes)
+		require.Equal(t, uint64(2*os.Getpagesize()), stat.UDPMemBytes)
+		require.Equal(t, uint64(2), stat.UDP6InUse)
+	})
+
+	t.Run("net stack: rates", func(t *testing.T) {
+		now := time.Now()
+		prev := &netStackCounters{
+			time: now,
+			counters: map[string]uint64{
+				"Tcp.RetransSegs": 100, "Tcp.OutRsts": 50, "TcpExt.ListenOverflows": 10,
+				"Udp.RcvbufErrors": 4, "Udp6.RcvbufErrors": 1, "Icmp.InErrors": 20,
+			},
+			sockstat: map[string]uint64{},
+		}
+		cur := &netStackCounters{
+			time: now.Add(2 * time.Second),
+			counters: map[string]uint64{
+				"Tcp.RetransSegs": 120, "Tcp.OutRsts": 50, "TcpExt.ListenOverflows": 14,
+				"Udp.RcvbufErrors": 8, "Udp6.RcvbufErrors": 3, "Icmp.InErrors": 5,
+			},
+			sockstat: map[string]uint64{"TCP.mem": 2},
+		}
+		stat := calcNetStackStat(prev, cur, 4096)
+		require.InDelta(t, 10.0, stat.TCPRetransSegs, 1e-9)
+		require.Zero(t, stat.TCPOutRsts)
+		require.InDelta(t, 2.0, stat.ListenOverflows, 1e-9)
+		require.InDelta(t, 3.0, stat.UDPRcvbufErrors, 1e-9)
+		// Счетчик сброшен, скорость за интервал не считается
+		require.Zero(t, stat.ICMPInErrors)
+		require.Equal(t, uint64(8192), stat.TCPMemBytes)
+	})
+
+	t.Run("net stack: Run() error", func(t *testing.T) {
+		cfg := netStackConfig(t.TempDir())
+		l := NewLinuxNetStackCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := l.Run()
+		require.Nil(t, ch)
+		require.ErrorIs(t, err, ErrNoNetStackStat)
+		require.False(t, cfg.Metrics.NetStack)
+	})
+
+	t.Run("net stack: metric enabled", func(t *testing.T) {
+		cfg := netStackConfig(fakeNetProc(t, allFiles))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		l := NewLinuxNetStackCollector(ctx, ctx, cfg, log)
+
+		ch, err := l.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.NotNil(t, val)
+		require.Zero(t, val.TCPRetransSegs)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.NetStack)
+	})
+}
@@ -11,8 +11,10 @@ type PacketInfo struct {
 	Protocol        string
 	SourcePort      string
 	DestinationPort string
+	Application     string
 	PayloadSize     uint64
 	Timestamp       time.Time
+	DNS             *DNSMessage
 }
 
 func (p *PacketInfo) ConnectionID() string {
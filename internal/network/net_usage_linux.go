@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/gopacket"
@@ -18,22 +19,24 @@ import (
 )
 
 type LinuxNetworkPackagesCollector struct {
-	serverCtx context.Context
-	clientCtx context.Context
-	cfg       *config.DaemonConfig
-	l         logger.Logger
-	r         *pb.Request
+	serverCtx  context.Context
+	clientCtx  context.Context
+	cfg        *config.DaemonConfig
+	l          logger.Logger
+	r          *pb.Request
+	classifier *Classifier
 }
 
 func NewLinuxNetworkPackagesCollector(
 	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger, r *pb.Request,
 ) *LinuxNetworkPackagesCollector {
 	return &LinuxNetworkPackagesCollector{
-		serverCtx: serverCtx,
-		clientCtx: clientCtx,
-		cfg:       cfg,
-		l:         l,
-		r:         r,
+		serverCtx:  serverCtx,
+		clientCtx:  clientCtx,
+		cfg:        cfg,
+		l:          l,
+		r:          r,
+		classifier: NewClassifier(cfg.Services),
 	}
 }
 
@@ -42,10 +45,12 @@ func (l *LinuxNetworkPackagesCollector) Run() (<-chan NetworkPacketStat, error)
 	if err != nil {
 		l.cfg.Metrics.NetTopByClients = false
 		l.cfg.Metrics.NetTopByProtocol = false
+		l.cfg.Metrics.NetTopByProcess = false
+		l.cfg.Metrics.DNS = false
 		return nil, err
 	}
 	ch := make(chan NetworkPacketStat)
-	sendTicker := time.NewTicker(time.Second)
+	sendTicker := time.NewTicker(l.cfg.Interval(config.CollectorNetPackets))
 
 	go func() {
 		defer close(ch)
@@ -63,13 +68,16 @@ func (l *LinuxNetworkPackagesCollector) Run() (<-chan NetworkPacketStat, error)
 				ch <- stat
 				stat = stat[:0]
 			default:
-				if !l.cfg.Metrics.NetTopByClients && !l.cfg.Metrics.NetTopByProtocol {
+				if !l.cfg.Metrics.NetTopByClients && !l.cfg.Metrics.NetTopByProtocol &&
+					!l.cfg.Metrics.NetTopByProcess && !l.cfg.Metrics.DNS {
 					continue
 				}
 				np, err := packetSource.NextPacket()
 				if err == nil && np != nil {
 					p := getPacket(np)
 					if p != nil {
+						p.Application = l.classifier.Classify(p, applicationPayload(np))
+						p.DNS = dnsMessage(np)
 						stat = append(stat, *p)
 					}
 				} else {
@@ -79,6 +87,8 @@ func (l *LinuxNetworkPackagesCollector) Run() (<-chan NetworkPacketStat, error)
 						l.l.Error("connections package collector error", "error", err.Error())
 						l.cfg.Metrics.NetTopByClients = false
 						l.cfg.Metrics.NetTopByProtocol = false
+						l.cfg.Metrics.NetTopByProcess = false
+						l.cfg.Metrics.DNS = false
 						return
 					}
 				}
@@ -96,6 +106,27 @@ func getPacket(packet gopacket.Packet) *PacketInfo {
 	return p
 }
 
+func applicationPayload(packet gopacket.Packet) []byte {
+	if app := packet.ApplicationLayer(); app != nil {
+		return app.Payload()
+	}
+	return nil
+}
+
+func dnsMessage(packet gopacket.Packet) *DNSMessage {
+	dnsLayer := packet.Layer(layers.LayerTypeDNS)
+	if dnsLayer == nil {
+		return nil
+	}
+	dns := dnsLayer.(*layers.DNS)
+	m := &DNSMessage{ID: dns.ID, Response: dns.QR, RCode: uint8(dns.ResponseCode)}
+	if len(dns.Questions) > 0 {
+		m.Name = strings.ToLower(strings.TrimSuffix(string(dns.Questions[0].Name), "."))
+		m.Type = dns.Questions[0].Type.String()
+	}
+	return m
+}
+
 func NetworkLayer(packet gopacket.Packet) *PacketInfo { //nolint:revive
 	var info PacketInfo
 	arpLayer := packet.Layer(layers.LayerTypeARP)
@@ -143,15 +174,15 @@ func TransportLayer(packet gopacket.Packet) *PacketInfo {
 	tcpLayer := packet.Layer(layers.LayerTypeTCP)
 	if tcpLayer != nil {
 		tcp := tcpLayer.(*layers.TCP)
-		info.SourcePort = tcp.SrcPort.String()
-		info.DestinationPort = tcp.DstPort.String()
+		info.SourcePort = strconv.Itoa(int(tcp.SrcPort))
+		info.DestinationPort = strconv.Itoa(int(tcp.DstPort))
 	}
 
 	udpLayer := packet.Layer(layers.LayerTypeUDP)
 	if udpLayer != nil {
 		udp := udpLayer.(*layers.UDP)
-		info.SourcePort = udp.SrcPort.String()
-		info.DestinationPort = udp.DstPort.String()
+		info.SourcePort = strconv.Itoa(int(udp.SrcPort))
+		info.DestinationPort = strconv.Itoa(int(udp.DstPort))
 	}
 
 	return &info
@@ -0,0 +1,95 @@
+package numa
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NodeStat - память узла NUMA в байтах и скорости счетчиков numastat в страницах в секунду.
+// NumaMiss - страницы, выделенные на узле вопреки предпочтению процесса, NumaForeign - страницы,
+// которые предназначались узлу, но были выделены на другом.
+type NodeStat struct {
+	Node          int
+	MemTotal      uint64
+	MemFree       uint64
+	MemUsed       uint64
+	NumaHit       float64
+	NumaMiss      float64
+	NumaForeign   float64
+	InterleaveHit float64
+	LocalNode     float64
+	OtherNode     float64
+}
+
+// HugePageStat - пул больших страниц одного размера, значения в страницах.
+type HugePageStat struct {
+	SizeBytes uint64
+	Total     uint64
+	Free      uint64
+	Reserved  uint64
+	Surplus   uint64
+}
+
+type Stat struct {
+	Nodes     []NodeStat
+	HugePages []HugePageStat
+}
+
+func (s *Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan *Stat, error)
+	Get() (*Stat, error)
+}
+
+// nodeCounters - значения meminfo (в байтах) и накопительные счетчики numastat одного узла.
+type nodeCounters struct {
+	node     int
+	meminfo  map[string]uint64
+	numastat map[string]uint64
+}
+
+type snapshot struct {
+	time  time.Time
+	nodes []nodeCounters
+}
+
+// calcNodes считает скорости счетчиков numastat с предыдущего снимка. Для первого снимка,
+// нового узла или сброса счетчика скорость нулевая.
+func calcNodes(prev, cur *snapshot) []NodeStat {
+	prevNodes := make(map[int]nodeCounters)
+	elapsed := 0.0
+	if prev != nil {
+		for _, n := range prev.nodes {
+			prevNodes[n.node] = n
+		}
+		elapsed = cur.time.Sub(prev.time).Seconds()
+	}
+
+	result := make([]NodeStat, 0, len(cur.nodes))
+	for _, n := range cur.nodes {
+		old, ok := prevNodes[n.node]
+		rate := func(name string) float64 {
+			if !ok || elapsed <= 0 || n.numastat[name] < old.numastat[name] {
+				return 0
+			}
+			return float64(n.numastat[name]-old.numastat[name]) / elapsed
+		}
+		result = append(result, NodeStat{
+			Node:          n.node,
+			MemTotal:      n.meminfo["MemTotal"],
+			MemFree:       n.meminfo["MemFree"],
+			MemUsed:       n.meminfo["MemUsed"],
+			NumaHit:       rate("numa_hit"),
+			NumaMiss:      rate("numa_miss"),
+			NumaForeign:   rate("numa_foreign"),
+			InterleaveHit: rate("interleave_hit"),
+			LocalNode:     rate("local_node"),
+			OtherNode:     rate("other_node"),
+		})
+	}
+	return result
+}
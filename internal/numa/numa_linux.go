@@ -0,0 +1,204 @@
+//go:build linux
+
+package numa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+var ErrNoNUMA = errors.New("neither numa nodes nor hugepages found in sysfs")
+
+type LinuxNUMACollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	prev      *snapshot
+}
+
+func NewLinuxNUMACollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxNUMACollector {
+	return &LinuxNUMACollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxNUMACollector) Run() (<-chan *Stat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("numa collector error", "error", err.Error())
+		l.cfg.Metrics.NUMA = false
+		return nil, err
+	}
+	ch := make(chan *Stat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorNUMA))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("numa collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.NUMA {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("numa collector error", "error", err.Error())
+					l.cfg.Metrics.NUMA = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает память и скорости numastat по узлам и пулы больших страниц. Ядро без поддержки
+// NUMA не создает каталоги узлов, в этом случае возвращаются только большие страницы.
+func (l *LinuxNUMACollector) Get() (*Stat, error) {
+	nodes, nodesErr := readNodes(filepath.Join(l.cfg.System.Sys, "devices", "system", "node"))
+	hugePages, hugePagesErr := readHugePages(filepath.Join(l.cfg.System.Sys, "kernel", "mm", "hugepages"))
+	if nodesErr != nil && hugePagesErr != nil {
+		return nil, ErrNoNUMA
+	}
+	cur := &snapshot{time: time.Now(), nodes: nodes}
+	stat := &Stat{Nodes: calcNodes(l.prev, cur), HugePages: hugePages}
+	l.prev = cur
+	return stat, nil
+}
+
+func readNodes(root string) ([]nodeCounters, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, os.ErrNotExist
+	}
+	result := make([]nodeCounters, 0, len(dirs))
+	for _, dir := range dirs {
+		node, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+		lines, err := utils.ReadLines(filepath.Join(dir, "meminfo"))
+		if err != nil {
+			return nil, err
+		}
+		meminfo, err := parseNodeMeminfo(lines)
+		if err != nil {
+			return nil, err
+		}
+		lines, err = utils.ReadLines(filepath.Join(dir, "numastat"))
+		if err != nil {
+			return nil, err
+		}
+		numastat, err := parseNumastat(lines)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodeCounters{node: node, meminfo: meminfo, numastat: numastat})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].node < result[j].node })
+	return result, nil
+}
+
+// parseNodeMeminfo разбирает строки вида "Node 0 MemTotal: 16307212 kB". Значения в килобайтах
+// переводятся в байты, счетчики страниц (HugePages_*) остаются как есть.
+func parseNodeMeminfo(lines []string) (map[string]uint64, error) {
+	result := make(map[string]uint64)
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 4 || fields[0] != "Node" {
+			return nil, fmt.Errorf("invalid node meminfo line: %q", line)
+		}
+		v, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node meminfo line: %q", line)
+		}
+		if len(fields) > 4 && fields[4] == "kB" {
+			v *= 1024
+		}
+		result[strings.TrimSuffix(fields[2], ":")] = v
+	}
+	return result, nil
+}
+
+func parseNumastat(lines []string) (map[string]uint64, error) {
+	result := make(map[string]uint64)
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid numastat line: %q", line)
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid numastat line: %q", line)
+		}
+		result[fields[0]] = v
+	}
+	return result, nil
+}
+
+// readHugePages читает пулы из каталогов вида hugepages-2048kB.
+func readHugePages(root string) ([]HugePageStat, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "hugepages-*kB"))
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, os.ErrNotExist
+	}
+	result := make([]HugePageStat, 0, len(dirs))
+	for _, dir := range dirs {
+		size := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(dir), "hugepages-"), "kB")
+		sizeKB, err := strconv.ParseUint(size, 10, 64)
+		if err != nil {
+			continue
+		}
+		item := HugePageStat{SizeBytes: sizeKB * 1024}
+		for name, value := range map[string]*uint64{
+			"nr_hugepages":      &item.Total,
+			"free_hugepages":    &item.Free,
+			"resv_hugepages":    &item.Reserved,
+			"surplus_hugepages": &item.Surplus,
+		} {
+			b, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			if *value, err = strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SizeBytes < result[j].SizeBytes })
+	return result, nil
+}
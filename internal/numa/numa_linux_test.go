@@ -0,0 +1,135 @@
+//go:build linux
+
+package numa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+const (
+	fakeMeminfo = `Node 0 MemTotal:        6158152 kB
+Node 0 MemFree:         2488376 kB
+Node 0 MemUsed:         3669776 kB
+Node 0 HugePages_Total:     4
+Node 0 HugePages_Free:      2`
+	fakeNumastat = `numa_hit 27479432
+numa_miss 0
+numa_foreign 0
+interleave_hit 1026
+local_node 27479432
+other_node 0`
+)
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(data+"\n"), 0o600))
+}
+
+func fakeSys(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "devices/system/node/node0/meminfo"), fakeMeminfo)
+	writeFile(t, filepath.Join(root, "devices/system/node/node0/numastat"), fakeNumastat)
+	writeFile(t, filepath.Join(root, "devices/system/node/online"), "0")
+	for _, size := range []string{"hugepages-2048kB", "hugepages-1048576kB"} {
+		for name, value := range map[string]string{
+			"nr_hugepages": "4", "free_hugepages": "2", "resv_hugepages": "1", "surplus_hugepages": "0",
+		} {
+			writeFile(t, filepath.Join(root, "kernel/mm/hugepages", size, name), value)
+		}
+	}
+	return root
+}
+
+func createConfig(sys string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Sys: sys},
+		Metrics: config.Metrics{NUMA: true},
+	}
+}
+
+func TestNUMA(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("numa: parsers", func(t *testing.T) {
+		meminfo, err := parseNodeMeminfo(strings.Split(fakeMeminfo, "\n"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]uint64{
+			"MemTotal":        6158152 * 1024,
+			"MemFree":         2488376 * 1024,
+			"MemUsed":         3669776 * 1024,
+			"HugePages_Total": 4,
+			"HugePages_Free":  2,
+		}, meminfo)
+		_, err = parseNodeMeminfo([]string{"MemTotal: 1 kB"})
+		require.Error(t, err)
+
+		numastat, err := parseNumastat(strings.Split(fakeNumastat, "\n"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1026), numastat["interleave_hit"])
+		_, err = parseNumastat([]string{"numa_hit x"})
+		require.Error(t, err)
+	})
+
+	t.Run("numa: Get()", func(t *testing.T) {
+		v := NewLinuxNUMACollector(context.TODO(), context.TODO(), createConfig(fakeSys(t)), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Equal(t, []NodeStat{
+			{Node: 0, MemTotal: 6158152 * 1024, MemFree: 2488376 * 1024, MemUsed: 3669776 * 1024},
+		}, val.Nodes)
+		require.Equal(t, []HugePageStat{
+			{SizeBytes: 2048 * 1024, Total: 4, Free: 2, Reserved: 1},
+			{SizeBytes: 1048576 * 1024, Total: 4, Free: 2, Reserved: 1},
+		}, val.HugePages)
+	})
+
+	t.Run("numa: hugepages only", func(t *testing.T) {
+		root := fakeSys(t)
+		require.NoError(t, os.RemoveAll(filepath.Join(root, "devices")))
+		v := NewLinuxNUMACollector(context.TODO(), context.TODO(), createConfig(root), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Empty(t, val.Nodes)
+		require.Len(t, val.HugePages, 2)
+	})
+
+	t.Run("numa: Run() error", func(t *testing.T) {
+		cfg := createConfig(t.TempDir())
+		v := NewLinuxNUMACollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := v.Run()
+		require.Nil(t, ch)
+		require.ErrorIs(t, err, ErrNoNUMA)
+		require.False(t, cfg.Metrics.NUMA)
+	})
+
+	t.Run("numa: metric enabled", func(t *testing.T) {
+		cfg := createConfig(fakeSys(t))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		v := NewLinuxNUMACollector(ctx, ctx, cfg, log)
+
+		ch, err := v.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.Len(t, val.Nodes, 1)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.NUMA)
+	})
+}
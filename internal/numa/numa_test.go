@@ -0,0 +1,31 @@
+package numa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcNodes(t *testing.T) {
+	now := time.Now()
+	prev := &snapshot{time: now, nodes: []nodeCounters{
+		{node: 0, numastat: map[string]uint64{"numa_hit": 1000, "numa_miss": 10, "numa_foreign": 50}},
+	}}
+	cur := &snapshot{time: now.Add(2 * time.Second), nodes: []nodeCounters{
+		{
+			node:     0,
+			meminfo:  map[string]uint64{"MemTotal": 4096, "MemFree": 1024, "MemUsed": 3072},
+			numastat: map[string]uint64{"numa_hit": 3000, "numa_miss": 30, "numa_foreign": 40},
+		},
+		{node: 1, numastat: map[string]uint64{"numa_hit": 500}},
+	}}
+	require.Equal(t, []NodeStat{
+		// numa_foreign уменьшился - счетчик сброшен
+		{Node: 0, MemTotal: 4096, MemFree: 1024, MemUsed: 3072, NumaHit: 1000, NumaMiss: 10},
+		{Node: 1},
+	}, calcNodes(prev, cur))
+
+	require.Equal(t, []NodeStat{{Node: 0, MemTotal: 4096, MemFree: 1024, MemUsed: 3072}, {Node: 1}},
+		calcNodes(nil, cur))
+}
@@ -0,0 +1,242 @@
+package plugins
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatJSON       = "json"
+	FormatPrometheus = "prometheus"
+	FormatInflux     = "influx"
+)
+
+var ErrUnknownFormat = errors.New("unknown plugin output format")
+
+// Parse разбирает вывод плагина в заданном формате.
+func Parse(format string, output []byte) ([]Metric, error) {
+	switch format {
+	case FormatJSON:
+		return parseJSON(output)
+	case FormatPrometheus:
+		return parsePrometheus(output)
+	case FormatInflux:
+		return parseInflux(output)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+}
+
+// parseJSON принимает массив [{"name": ..., "value": ..., "labels": {...}}]
+// либо объект {"name": value} без меток.
+func parseJSON(output []byte) ([]Metric, error) {
+	var list []struct {
+		Name   string            `json:"name"`
+		Value  float64           `json:"value"`
+		Labels map[string]string `json:"labels"`
+	}
+	if err := json.Unmarshal(output, &list); err == nil {
+		result := make([]Metric, 0, len(list))
+		for _, m := range list {
+			if m.Name == "" {
+				return nil, errors.New("metric without name")
+			}
+			result = append(result, Metric{Name: m.Name, Labels: m.Labels, Value: m.Value})
+		}
+		return result, nil
+	}
+
+	var flat map[string]float64
+	if err := json.Unmarshal(output, &flat); err != nil {
+		return nil, err
+	}
+	result := make([]Metric, 0, len(flat))
+	for name, value := range flat {
+		result = append(result, Metric{Name: name, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// parsePrometheus разбирает текстовый формат Prometheus: name{label="value"} value [timestamp].
+// Комментарии и метаданные (# HELP, # TYPE) пропускаются.
+func parsePrometheus(output []byte) ([]Metric, error) {
+	result := make([]Metric, 0)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m := Metric{}
+		rest := line
+		if i := strings.IndexAny(line, "{ \t"); i >= 0 {
+			m.Name = line[:i]
+			rest = line[i:]
+		}
+		if strings.HasPrefix(rest, "{") {
+			labels, tail, err := parsePrometheusLabels(rest[1:])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", line, err)
+			}
+			m.Labels = labels
+			rest = tail
+		}
+		fields := strings.Fields(rest)
+		if m.Name == "" || len(fields) == 0 {
+			return nil, fmt.Errorf("invalid line: %s", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line: %s", line)
+		}
+		m.Value = value
+		result = append(result, m)
+	}
+	return result, scanner.Err()
+}
+
+// parsePrometheusLabels разбирает метки до закрывающей скобки и возвращает остаток строки.
+func parsePrometheusLabels(s string) (map[string]string, string, error) {
+	labels := make(map[string]string)
+	for {
+		s = strings.TrimLeft(s, " ,")
+		if strings.HasPrefix(s, "}") {
+			return labels, s[1:], nil
+		}
+		eq := strings.Index(s, "=")
+		if eq < 0 || len(s) < eq+2 || s[eq+1] != '"' {
+			return nil, "", errors.New("invalid labels")
+		}
+		name := strings.TrimSpace(s[:eq])
+		s = s[eq+2:]
+
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(s); i++ {
+			switch {
+			case s[i] == '\\' && i+1 < len(s):
+				i++
+				if s[i] == 'n' {
+					value.WriteByte('\n')
+				} else {
+					value.WriteByte(s[i])
+				}
+			case s[i] == '"':
+				s = s[i+1:]
+				closed = true
+			default:
+				value.WriteByte(s[i])
+			}
+			if closed {
+				break
+			}
+		}
+		if !closed {
+			return nil, "", errors.New("unterminated label value")
+		}
+		labels[name] = value.String()
+	}
+}
+
+// parseInflux разбирает line protocol: measurement,tag=value field=1,other=2i [timestamp].
+// Каждое числовое поле становится метрикой measurement_field, поле value - метрикой measurement.
+// Логические поля приводятся к 0 и 1, строковые пропускаются.
+func parseInflux(output []byte) ([]Metric, error) {
+	result := make([]Metric, 0)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := make([]string, 0, 3)
+		for _, p := range splitEscaped(line, ' ') {
+			if p != "" {
+				parts = append(parts, p)
+			}
+		}
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid line: %s", line)
+		}
+		series := splitEscaped(parts[0], ',')
+		measurement := unescape(series[0])
+		var labels map[string]string
+		for _, tag := range series[1:] {
+			kv := splitEscaped(tag, '=')
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid tag in line: %s", line)
+			}
+			if labels == nil {
+				labels = make(map[string]string, len(series)-1)
+			}
+			labels[unescape(kv[0])] = unescape(kv[1])
+		}
+
+		for _, field := range splitEscaped(parts[1], ',') {
+			kv := splitEscaped(field, '=')
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid field in line: %s", line)
+			}
+			value, ok := influxValue(kv[1])
+			if !ok {
+				continue
+			}
+			name := measurement
+			if key := unescape(kv[0]); key != "value" {
+				name += "_" + key
+			}
+			result = append(result, Metric{Name: name, Labels: labels, Value: value})
+		}
+	}
+	return result, scanner.Err()
+}
+
+func influxValue(s string) (float64, bool) {
+	switch s {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, true
+	case "f", "F", "false", "False", "FALSE":
+		return 0, true
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "i"), "u")
+	v, err := strconv.ParseFloat(s, 64)
+	return v, err == nil
+}
+
+// splitEscaped делит строку по разделителю, не учитывая экранированные символы и строки в кавычках.
+func splitEscaped(s string, sep byte) []string {
+	result := make([]string, 0)
+	start := 0
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			result = append(result, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(result, s[start:])
+}
+
+func unescape(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
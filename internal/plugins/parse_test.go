@@ -0,0 +1,93 @@
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("json: list", func(t *testing.T) {
+		out := `[{"name": "queue_depth", "value": 12, "labels": {"queue": "mail"}}, {"name": "workers", "value": 4}]`
+		metrics, err := Parse(FormatJSON, []byte(out))
+		require.NoError(t, err)
+		require.Equal(t, []Metric{
+			{Name: "queue_depth", Labels: map[string]string{"queue": "mail"}, Value: 12},
+			{Name: "workers", Value: 4},
+		}, metrics)
+	})
+
+	t.Run("json: object", func(t *testing.T) {
+		metrics, err := Parse(FormatJSON, []byte(`{"b": 2.5, "a": 1}`))
+		require.NoError(t, err)
+		require.Equal(t, []Metric{{Name: "a", Value: 1}, {Name: "b", Value: 2.5}}, metrics)
+	})
+
+	t.Run("json: invalid", func(t *testing.T) {
+		_, err := Parse(FormatJSON, []byte(`{"a": "text"}`))
+		require.Error(t, err)
+		_, err = Parse(FormatJSON, []byte(`[{"value": 1}]`))
+		require.Error(t, err)
+	})
+
+	t.Run("prometheus", func(t *testing.T) {
+		out := `# HELP http_requests_total Requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="get", path="/a \"b\""} 3
+uptime_seconds 12.5
+
+temperature{} -1.5e1
+`
+		metrics, err := Parse(FormatPrometheus, []byte(out))
+		require.NoError(t, err)
+		require.Equal(t, []Metric{
+			{Name: "http_requests_total", Labels: map[string]string{"method": "post", "code": "200"}, Value: 1027},
+			{Name: "http_requests_total", Labels: map[string]string{"method": "get", "path": `/a "b"`}, Value: 3},
+			{Name: "uptime_seconds", Value: 12.5},
+			{Name: "temperature", Labels: map[string]string{}, Value: -15},
+		}, metrics)
+	})
+
+	t.Run("prometheus: invalid", func(t *testing.T) {
+		for _, out := range []string{"metric", "metric abc", `metric{a="b} 1`, `metric{a=b} 1`} {
+			_, err := Parse(FormatPrometheus, []byte(out))
+			require.Error(t, err, out)
+		}
+	})
+
+	t.Run("influx", func(t *testing.T) {
+		out := `cpu,host=server\ 01,region=eu usage=0.64,cores=8i,online=true,model="x y" 1465839830100400200
+disk value=42
+`
+		metrics, err := Parse(FormatInflux, []byte(out))
+		require.NoError(t, err)
+		labels := map[string]string{"host": "server 01", "region": "eu"}
+		require.Equal(t, []Metric{
+			{Name: "cpu_usage", Labels: labels, Value: 0.64},
+			{Name: "cpu_cores", Labels: labels, Value: 8},
+			{Name: "cpu_online", Labels: labels, Value: 1},
+			{Name: "disk", Value: 42},
+		}, metrics)
+	})
+
+	t.Run("influx: invalid", func(t *testing.T) {
+		for _, out := range []string{"cpu", "cpu,host usage=1", "cpu usage"} {
+			_, err := Parse(FormatInflux, []byte(out))
+			require.Error(t, err, out)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := Parse("xml", nil)
+		require.ErrorIs(t, err, ErrUnknownFormat)
+	})
+}
+
+func TestMetricKey(t *testing.T) {
+	a := Metric{Plugin: "p", Name: "m", Labels: map[string]string{"b": "2", "a": "1"}}
+	b := Metric{Plugin: "p", Name: "m", Labels: map[string]string{"a": "1", "b": "2"}}
+	require.Equal(t, a.Key(), b.Key())
+	require.Equal(t, "p/m,a=1,b=2", a.Key())
+	require.NotEqual(t, a.Key(), Metric{Plugin: "p", Name: "m"}.Key())
+}
@@ -0,0 +1,233 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 5 * time.Second
+	// Время ожидания завершения потоков вывода после принудительной остановки команды.
+	waitDelay = time.Second
+)
+
+// Metric - именованное значение, полученное от плагина.
+type Metric struct {
+	Plugin string
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Key однозначно определяет временной ряд: плагин, имя и набор меток.
+func (m Metric) Key() string {
+	names := make([]string, 0, len(m.Labels))
+	for name := range m.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString(m.Plugin + "/" + m.Name)
+	for _, name := range names {
+		b.WriteString("," + name + "=" + m.Labels[name])
+	}
+	return b.String()
+}
+
+type Stat []Metric
+
+func (s Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan Stat, error)
+	Get() (Stat, error)
+}
+
+// Runner запускает команды плагинов, каждую со своим периодом, и хранит последние успешно
+// полученные от них значения. Runner работает на уровне демона, потоки клиентов читают значения
+// из него, поэтому каждая команда выполняется один раз за период независимо от числа клиентов.
+// Список плагинов читается при запуске.
+type Runner struct {
+	ctx     context.Context
+	cfg     *config.DaemonConfig
+	l       logger.Logger
+	plugins []config.Plugin
+
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	results map[string][]Metric
+}
+
+func NewRunner(ctx context.Context, cfg *config.DaemonConfig, l logger.Logger) *Runner {
+	return &Runner{
+		ctx:     ctx,
+		cfg:     cfg,
+		l:       l,
+		plugins: slices.Clone(cfg.Plugins),
+		results: make(map[string][]Metric),
+	}
+}
+
+// Start запускает плагины до отмены контекста Runner.
+func (r *Runner) Start() {
+	for _, p := range r.plugins {
+		r.wg.Add(1)
+		go r.runPlugin(p)
+	}
+}
+
+// Wait ожидает завершения плагинов после отмены контекста.
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+// Get возвращает последние значения плагинов в порядке их описания в настройках.
+func (r *Runner) Get() Stat {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make(Stat, 0)
+	for _, p := range r.plugins {
+		result = append(result, r.results[p.Name]...)
+	}
+	return result
+}
+
+// ExecCollector раз в секунду отдает клиенту последние значения плагинов из Runner.
+// Пустой список плагинов означает, что метрика выключена: сборщик не передает значения.
+type ExecCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	runner    *Runner
+}
+
+// NewExecCollector создает сборщик. Если runner не задан, сборщик запускает плагины сам
+// на время подключения клиента.
+func NewExecCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger, runner *Runner,
+) *ExecCollector {
+	return &ExecCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+		runner:    runner,
+	}
+}
+
+func (c *ExecCollector) Run() (<-chan Stat, error) {
+	own := c.runner == nil
+	if own {
+		c.runner = NewRunner(c.clientCtx, c.cfg, c.l)
+		c.runner.Start()
+	}
+	ch := make(chan Stat)
+	ticker := time.NewTicker(c.cfg.Interval(config.CollectorPlugins))
+
+	go func() {
+		defer close(ch)
+		defer ticker.Stop()
+		if own {
+			defer c.runner.Wait()
+		}
+		for {
+			select {
+			case <-c.serverCtx.Done():
+			case <-c.clientCtx.Done():
+				c.l.Debug("plugins collector stopped")
+				return
+			case <-ticker.C:
+				if !c.cfg.Metrics.Plugins || len(c.runner.plugins) == 0 {
+					continue
+				}
+				ch <- c.runner.Get()
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает последние значения плагинов.
+func (c *ExecCollector) Get() (Stat, error) {
+	if c.runner == nil {
+		return Stat{}, nil
+	}
+	return c.runner.Get(), nil
+}
+
+func (r *Runner) setResult(name string, metrics []Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results[name] = metrics
+}
+
+func (r *Runner) runPlugin(p config.Plugin) {
+	defer r.wg.Done()
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if r.cfg.Metrics.Plugins {
+			r.execute(p)
+		}
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// execute выполняет команду плагина. При ошибке значения плагина сбрасываются,
+// чтобы клиент не получал устаревшие данные.
+func (r *Runner) execute(p config.Plugin) {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, p.Command[0], p.Command[1:]...) //nolint:gosec
+	cmd.WaitDelay = waitDelay
+	out, err := cmd.Output()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		r.l.Warn("plugin timed out", "plugin", p.Name, "timeout", timeout.String())
+		r.setResult(p.Name, nil)
+		return
+	}
+	if err != nil {
+		r.l.Warn("plugin failed", "plugin", p.Name, "error", err.Error())
+		r.setResult(p.Name, nil)
+		return
+	}
+	metrics, err := Parse(p.Format, out)
+	if err != nil {
+		r.l.Warn("plugin output parse error", "plugin", p.Name, "error", err.Error())
+		r.setResult(p.Name, nil)
+		return
+	}
+	for i := range metrics {
+		metrics[i].Plugin = p.Name
+	}
+	r.setResult(p.Name, metrics)
+}
@@ -0,0 +1,109 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+func createConfig(plugins ...config.Plugin) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		Metrics: config.Metrics{Plugins: true},
+		Plugins: plugins,
+	}
+}
+
+func TestExecCollector(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("plugins: execute", func(t *testing.T) {
+		cfg := createConfig(
+			config.Plugin{Name: "ok", Command: []string{"echo", `{"a": 1}`}, Format: FormatJSON},
+			config.Plugin{Name: "fail", Command: []string{"sh", "-c", "exit 1"}, Format: FormatJSON},
+			config.Plugin{Name: "garbage", Command: []string{"echo", "a b c"}, Format: FormatPrometheus},
+			config.Plugin{
+				Name: "slow", Command: []string{"sleep", "5"}, Format: FormatJSON, Timeout: 100 * time.Millisecond,
+			},
+		)
+		r := NewRunner(context.TODO(), cfg, log)
+		r.setResult("fail", []Metric{{Plugin: "fail", Name: "stale"}})
+		r.setResult("slow", []Metric{{Plugin: "slow", Name: "stale"}})
+		for _, p := range cfg.Plugins {
+			r.execute(p)
+		}
+		require.Equal(t, Stat{{Plugin: "ok", Name: "a", Value: 1}}, r.Get())
+	})
+
+	t.Run("plugins: Run() without plugins", func(t *testing.T) {
+		cfg := createConfig()
+		ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+		defer cancel()
+		c := NewExecCollector(ctx, ctx, cfg, log, nil)
+		ch, err := c.Run()
+		require.NoError(t, err)
+		// Пустой список плагинов означает выключенную метрику: значения не передаются,
+		// общие настройки не изменяются
+		for range ch {
+			t.Fatal("unexpected plugin values")
+		}
+		require.True(t, cfg.Metrics.Plugins)
+	})
+
+	t.Run("plugins: shared runner", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := createConfig(config.Plugin{
+			Name:     "count",
+			Command:  []string{"sh", "-c", "echo x >> " + dir + "/runs; echo runs $(wc -l < " + dir + "/runs)"},
+			Format:   FormatPrometheus,
+			Interval: time.Hour,
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		r := NewRunner(ctx, cfg, log)
+		r.Start()
+
+		// Каждый клиент получает значения общего Runner, команда выполняется один раз
+		channels := make([]<-chan Stat, 0, 3)
+		for i := 0; i < 3; i++ {
+			ch, err := NewExecCollector(ctx, ctx, cfg, log, r).Run()
+			require.NoError(t, err)
+			channels = append(channels, ch)
+		}
+		for _, ch := range channels {
+			require.Equal(t, Stat{{Plugin: "count", Name: "runs", Value: 1}}, <-ch)
+		}
+		cancel()
+		for _, ch := range channels {
+			for range ch {
+			}
+		}
+		r.Wait()
+	})
+
+	t.Run("plugins: metric enabled", func(t *testing.T) {
+		cfg := createConfig(config.Plugin{
+			Name: "uptime", Command: []string{"echo", "uptime_seconds 10"}, Format: FormatPrometheus,
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		c := NewExecCollector(ctx, ctx, cfg, log, nil)
+
+		ch, err := c.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.Equal(t, Stat{{Plugin: "uptime", Name: "uptime_seconds", Value: 10}}, val)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.Plugins)
+	})
+}
@@ -0,0 +1,77 @@
+package query
+
+import (
+	"strconv"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+func ip(a *pb.SockAddr) string {
+	return a.GetIp()
+}
+
+func port(a *pb.SockAddr) float64 {
+	return float64(a.GetPort())
+}
+
+var connectionFields = map[string]field[*pb.NetConnection]{
+	"protocol":     text(func(v *pb.NetConnection) string { return v.Protocol }),
+	"state":        text(func(v *pb.NetConnection) string { return v.State }),
+	"user":         text(func(v *pb.NetConnection) string { return v.User }),
+	"uid":          number(func(v *pb.NetConnection) float64 { return float64(v.UserId) }),
+	"pid":          number(func(v *pb.NetConnection) float64 { return float64(v.GetProcess().GetPid()) }),
+	"process":      text(func(v *pb.NetConnection) string { return v.GetProcess().GetCmdLine() }),
+	"local_ip":     text(func(v *pb.NetConnection) string { return ip(v.LocalAddr) }),
+	"local_port":   number(func(v *pb.NetConnection) float64 { return port(v.LocalAddr) }),
+	"foreign_ip":   text(func(v *pb.NetConnection) string { return ip(v.ForeignAddr) }),
+	"foreign_port": number(func(v *pb.NetConnection) float64 { return port(v.ForeignAddr) }),
+	"ip": texts(func(v *pb.NetConnection) []string {
+		return []string{ip(v.LocalAddr), ip(v.ForeignAddr)}
+	}),
+	"port": numbers(func(v *pb.NetConnection) []float64 {
+		return []float64{port(v.LocalAddr), port(v.ForeignAddr)}
+	}),
+}
+
+var topByConnectionFields = map[string]field[*pb.NetTopByConnection]{
+	"protocol": text(func(v *pb.NetTopByConnection) string { return v.Protocol }),
+	"bytes":    number(func(v *pb.NetTopByConnection) float64 { return float64(v.Bytes) }),
+	"percent":  number(func(v *pb.NetTopByConnection) float64 { return v.Percent }),
+	"src_ip":   text(func(v *pb.NetTopByConnection) string { return ip(v.SourceAddr) }),
+	"src_port": number(func(v *pb.NetTopByConnection) float64 { return port(v.SourceAddr) }),
+	"dst_ip":   text(func(v *pb.NetTopByConnection) string { return ip(v.DestinationAddr) }),
+	"dst_port": number(func(v *pb.NetTopByConnection) float64 { return port(v.DestinationAddr) }),
+	"ip": texts(func(v *pb.NetTopByConnection) []string {
+		return []string{ip(v.SourceAddr), ip(v.DestinationAddr)}
+	}),
+	"port": numbers(func(v *pb.NetTopByConnection) []float64 {
+		return []float64{port(v.SourceAddr), port(v.DestinationAddr)}
+	}),
+}
+
+var diskUsageFields = map[string]field[*pb.DiskUsage]{
+	"device":        text(func(v *pb.DiskUsage) string { return v.Device }),
+	"mount":         text(func(v *pb.DiskUsage) string { return v.MountPoint }),
+	"fs_type":       text(func(v *pb.DiskUsage) string { return v.FsType }),
+	"read_only":     text(func(v *pb.DiskUsage) string { return strconv.FormatBool(v.ReadOnly) }),
+	"stale":         text(func(v *pb.DiskUsage) string { return strconv.FormatBool(v.Stale) }),
+	"usage_percent": number(func(v *pb.DiskUsage) float64 { return v.UsagePercent }),
+	"total":         number(func(v *pb.DiskUsage) float64 { return float64(v.Total) }),
+	"used":          number(func(v *pb.DiskUsage) float64 { return float64(v.Used) }),
+	"free":          number(func(v *pb.DiskUsage) float64 { return float64(v.Free) }),
+	"inodes_used":   number(func(v *pb.DiskUsage) float64 { return float64(v.InodesUsed) }),
+	"inodes_free":   number(func(v *pb.DiskUsage) float64 { return float64(v.InodesFree) }),
+	"inode_available_percent": number(func(v *pb.DiskUsage) float64 {
+		return v.InodeAvailablePercent
+	}),
+}
+
+var diskIOFields = map[string]field[*pb.DiskIO]{
+	"name":     text(func(v *pb.DiskIO) string { return v.Name }),
+	"label":    text(func(v *pb.DiskIO) string { return v.Label }),
+	"kind":     text(func(v *pb.DiskIO) string { return v.Kind }),
+	"disk":     texts(func(v *pb.DiskIO) []string { return v.Disks }),
+	"tps":      number(func(v *pb.DiskIO) float64 { return v.Tps }),
+	"rd_speed": number(func(v *pb.DiskIO) float64 { return v.RdSpeed }),
+	"wr_speed": number(func(v *pb.DiskIO) float64 { return v.WrSpeed }),
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Parse разбирает запрос, заданный строкой вида
+// "target;filter=<условия>;order=<поле>;desc;limit=<N>". Все части, кроме списка, необязательны.
+func Parse(s string) (*pb.Query, error) {
+	parts := strings.Split(s, ";")
+	result := &pb.Query{Target: strings.TrimSpace(parts[0])}
+	if result.Target == "" {
+		return nil, fmt.Errorf("%w: empty target in %q", ErrInvalidQuery, s)
+	}
+	for _, part := range parts[1:] {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "filter":
+			result.Filter = value
+		case "order":
+			result.OrderBy = value
+		case "desc":
+			result.Desc = true
+		case "limit":
+			limit, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid limit %q", ErrInvalidQuery, value)
+			}
+			result.Limit = uint32(limit)
+		default:
+			return nil, fmt.Errorf("%w: unknown option %q", ErrInvalidQuery, key)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,183 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+var (
+	ErrInvalidQuery = errors.New("invalid query")
+	ErrUnknownField = errors.New("unknown field")
+)
+
+var (
+	andRe       = regexp.MustCompile(`(?i)\s+and\s+`)
+	conditionRe = regexp.MustCompile(`^\s*([a-z_]+)\s*(>=|<=|!=|=|>|<)\s*(.*?)\s*$`)
+)
+
+// field - поле элемента списка, доступное в запросе. Поле может иметь несколько значений,
+// например, порт соединения - локальный и удаленный. Заполнена одна из функций.
+type field[T any] struct {
+	text   func(T) []string
+	number func(T) []float64
+}
+
+func text[T any](get func(T) string) field[T] {
+	return field[T]{text: func(v T) []string { return []string{get(v)} }}
+}
+
+func texts[T any](get func(T) []string) field[T] {
+	return field[T]{text: get}
+}
+
+func number[T any](get func(T) float64) field[T] {
+	return field[T]{number: func(v T) []float64 { return []float64{get(v)} }}
+}
+
+func numbers[T any](get func(T) []float64) field[T] {
+	return field[T]{number: get}
+}
+
+// compiled - разобранный запрос к списку элементов типа T.
+type compiled[T any] struct {
+	conditions []func(T) bool
+	less       func(a, b T) bool
+	limit      int
+}
+
+func compile[T any](q *pb.Query, fields map[string]field[T]) (*compiled[T], error) {
+	result := &compiled[T]{limit: int(q.Limit)}
+	if filter := strings.TrimSpace(q.Filter); filter != "" {
+		for _, item := range andRe.Split(filter, -1) {
+			c, err := condition(item, fields)
+			if err != nil {
+				return nil, err
+			}
+			result.conditions = append(result.conditions, c)
+		}
+	}
+	if q.OrderBy != "" {
+		f, ok := fields[q.OrderBy]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, q.OrderBy)
+		}
+		result.less = order(f, q.Desc)
+	}
+	return result, nil
+}
+
+// condition разбирает условие вида "field op value". Условие выполняется, если ему соответствует
+// хотя бы одно значение поля, условие != - если ему соответствуют все значения.
+func condition[T any](s string, fields map[string]field[T]) (func(T) bool, error) {
+	m := conditionRe.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidQuery, s)
+	}
+	name, op, value := m[1], m[2], strings.Trim(m[3], `"'`)
+	f, ok := fields[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, name)
+	}
+
+	if f.text != nil {
+		switch op {
+		case "=":
+			return func(v T) bool { return anyOf(f.text(v), func(s string) bool { return s == value }) }, nil
+		case "!=":
+			return func(v T) bool { return !anyOf(f.text(v), func(s string) bool { return s == value }) }, nil
+		default:
+			return nil, fmt.Errorf("%w: operator %s is not supported for field %s", ErrInvalidQuery, op, name)
+		}
+	}
+
+	x, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: field %s requires a number, got %q", ErrInvalidQuery, name, value)
+	}
+	if op == "!=" {
+		return func(v T) bool { return !anyOf(f.number(v), func(n float64) bool { return n == x }) }, nil
+	}
+	compare := map[string]func(float64) bool{
+		"=":  func(n float64) bool { return n == x },
+		">":  func(n float64) bool { return n > x },
+		"<":  func(n float64) bool { return n < x },
+		">=": func(n float64) bool { return n >= x },
+		"<=": func(n float64) bool { return n <= x },
+	}[op]
+	return func(v T) bool { return anyOf(f.number(v), compare) }, nil
+}
+
+// order возвращает функцию сравнения элементов по первому значению поля.
+func order[T any](f field[T], desc bool) func(a, b T) bool {
+	if f.text != nil {
+		return func(a, b T) bool {
+			x, y := first(f.text(a)), first(f.text(b))
+			if desc {
+				return x > y
+			}
+			return x < y
+		}
+	}
+	return func(a, b T) bool {
+		x, y := first(f.number(a)), first(f.number(b))
+		if desc {
+			return x > y
+		}
+		return x < y
+	}
+}
+
+func (c *compiled[T]) apply(items []T) []T {
+	if c == nil || items == nil {
+		return items
+	}
+	result := make([]T, 0, len(items))
+	for _, v := range items {
+		if c.match(v) {
+			result = append(result, v)
+		}
+	}
+	if c.less != nil {
+		sort.SliceStable(result, func(i, j int) bool { return c.less(result[i], result[j]) })
+	}
+	if c.limit > 0 && len(result) > c.limit {
+		result = result[:c.limit]
+	}
+	return result
+}
+
+func (c *compiled[T]) ordered() bool {
+	return c != nil && c.less != nil
+}
+
+func (c *compiled[T]) match(v T) bool {
+	for _, cond := range c.conditions {
+		if !cond(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func anyOf[V any](values []V, f func(V) bool) bool {
+	for _, v := range values {
+		if f(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func first[V any](values []V) V {
+	var result V
+	if len(values) > 0 {
+		result = values[0]
+	}
+	return result
+}
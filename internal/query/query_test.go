@@ -0,0 +1,126 @@
+package query
+
+import (
+	"testing"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+)
+
+func connection(user, state string, local, foreign uint32) *pb.NetConnection {
+	return &pb.NetConnection{
+		SocketId:    user + state,
+		Protocol:    "tcp",
+		User:        user,
+		State:       state,
+		LocalAddr:   &pb.SockAddr{Ip: "10.0.0.1", Port: local},
+		ForeignAddr: &pb.SockAddr{Ip: "10.0.0.2", Port: foreign},
+	}
+}
+
+func snapshot() *pb.Snapshot {
+	return &pb.Snapshot{
+		NetConnections: []*pb.NetConnection{
+			connection("root", "LISTEN", 22, 0),
+			connection("www", "ESTABLISHED", 443, 50000),
+			connection("root", "ESTABLISHED", 50001, 443),
+			connection("app", "ESTABLISHED", 8080, 50002),
+		},
+		NetTopByConnection: []*pb.NetTopByConnection{
+			{Protocol: "tcp", Bytes: 10}, {Protocol: "udp", Bytes: 300}, {Protocol: "tcp", Bytes: 20},
+		},
+		DiskUsage: []*pb.DiskUsage{
+			{MountPoint: "/", UsagePercent: 90}, {MountPoint: "/home", UsagePercent: 40, ReadOnly: true},
+		},
+	}
+}
+
+func users(items []*pb.NetConnection) []string {
+	result := make([]string, 0, len(items))
+	for _, v := range items {
+		result = append(result, v.User)
+	}
+	return result
+}
+
+func TestQuery(t *testing.T) {
+	t.Run("query: filter", func(t *testing.T) {
+		s, err := New([]*pb.Query{
+			{Target: TargetNetConnections, Filter: "state=ESTABLISHED and port=443"},
+			{Target: TargetDiskUsage, Filter: "usage_percent >= 50 AND read_only = false"},
+		})
+		require.NoError(t, err)
+		data := snapshot()
+		s.Apply(data)
+		require.Equal(t, []string{"www", "root"}, users(data.NetConnections))
+		require.Len(t, data.DiskUsage, 1)
+		require.Equal(t, "/", data.DiskUsage[0].MountPoint)
+		// Запроса к списку нет, список не меняется
+		require.Len(t, data.NetTopByConnection, 3)
+		require.Nil(t, data.DiskIO)
+	})
+
+	t.Run("query: not equal", func(t *testing.T) {
+		s, err := New([]*pb.Query{{Target: TargetNetConnections, Filter: `user!="root" and port!=8080`}})
+		require.NoError(t, err)
+		data := snapshot()
+		s.Apply(data)
+		require.Equal(t, []string{"www"}, users(data.NetConnections))
+	})
+
+	t.Run("query: order and limit", func(t *testing.T) {
+		s, err := New([]*pb.Query{
+			{Target: TargetNetTopByConnection, OrderBy: "bytes", Desc: true, Limit: 2},
+			{Target: TargetNetConnections, OrderBy: "user"},
+		})
+		require.NoError(t, err)
+		data := snapshot()
+		s.Apply(data)
+		require.Len(t, data.NetTopByConnection, 2)
+		require.Equal(t, uint64(300), data.NetTopByConnection[0].Bytes)
+		require.Equal(t, uint64(20), data.NetTopByConnection[1].Bytes)
+		require.Equal(t, []string{"app", "root", "root", "www"}, users(data.NetConnections))
+	})
+
+	t.Run("query: errors", func(t *testing.T) {
+		for _, q := range []*pb.Query{
+			{Target: "sensors"},
+			{Target: TargetDiskIO, Filter: "speed > 1"},
+			{Target: TargetDiskIO, Filter: "tps > fast"},
+			{Target: TargetDiskIO, Filter: "name > sda"},
+			{Target: TargetDiskIO, Filter: "tps"},
+			{Target: TargetDiskIO, OrderBy: "speed"},
+		} {
+			_, err := New([]*pb.Query{q})
+			require.Error(t, err, q.String())
+		}
+		_, err := New([]*pb.Query{{Target: TargetDiskIO}, {Target: TargetDiskIO}})
+		require.ErrorIs(t, err, ErrInvalidQuery)
+	})
+
+	t.Run("query: empty set", func(t *testing.T) {
+		var s *Set
+		data := snapshot()
+		s.Apply(data)
+		require.Len(t, data.NetConnections, 4)
+	})
+
+	t.Run("query: parse", func(t *testing.T) {
+		q, err := Parse("diskIO;filter=name=sda and tps > 1;order=wr_speed;desc;limit=5")
+		require.NoError(t, err)
+		require.Equal(t, TargetDiskIO, q.Target)
+		require.Equal(t, "name=sda and tps > 1", q.Filter)
+		require.Equal(t, "wr_speed", q.OrderBy)
+		require.True(t, q.Desc)
+		require.Equal(t, uint32(5), q.Limit)
+
+		q, err = Parse("diskUsage")
+		require.NoError(t, err)
+		require.Equal(t, &pb.Query{Target: TargetDiskUsage}, q)
+
+		for _, s := range []string{"", ";order=tps", "diskIO;limit=many", "diskIO;sort=tps"} {
+			_, err = Parse(s)
+			require.ErrorIs(t, err, ErrInvalidQuery, s)
+		}
+	})
+}
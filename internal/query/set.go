@@ -0,0 +1,82 @@
+package query
+
+import (
+	"fmt"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// Списки снимка, к которым применяются запросы.
+const (
+	TargetNetConnections     = "netConnections"
+	TargetNetTopByConnection = "netTopByConnection"
+	TargetDiskUsage          = "diskUsage"
+	TargetDiskIO             = "diskIO"
+)
+
+// Set - запросы клиента к спискам снимка.
+type Set struct {
+	connections     *compiled[*pb.NetConnection]
+	topByConnection *compiled[*pb.NetTopByConnection]
+	diskUsage       *compiled[*pb.DiskUsage]
+	diskIO          *compiled[*pb.DiskIO]
+}
+
+// New разбирает запросы. Для каждого списка допускается не больше одного запроса.
+func New(queries []*pb.Query) (*Set, error) {
+	result := &Set{}
+	seen := make(map[string]bool, len(queries))
+	for _, q := range queries {
+		if seen[q.Target] {
+			return nil, fmt.Errorf("%w: duplicate target %s", ErrInvalidQuery, q.Target)
+		}
+		seen[q.Target] = true
+
+		var err error
+		switch q.Target {
+		case TargetNetConnections:
+			result.connections, err = compile(q, connectionFields)
+		case TargetNetTopByConnection:
+			result.topByConnection, err = compile(q, topByConnectionFields)
+		case TargetDiskUsage:
+			result.diskUsage, err = compile(q, diskUsageFields)
+		case TargetDiskIO:
+			result.diskIO, err = compile(q, diskIOFields)
+		default:
+			return nil, fmt.Errorf("%w: unknown target %q", ErrInvalidQuery, q.Target)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", q.Target, err)
+		}
+	}
+	return result, nil
+}
+
+// Ordered сообщает, задан ли запросом порядок элементов списка target.
+func (s *Set) Ordered(target string) bool {
+	if s == nil {
+		return false
+	}
+	switch target {
+	case TargetNetConnections:
+		return s.connections.ordered()
+	case TargetNetTopByConnection:
+		return s.topByConnection.ordered()
+	case TargetDiskUsage:
+		return s.diskUsage.ordered()
+	case TargetDiskIO:
+		return s.diskIO.ordered()
+	}
+	return false
+}
+
+// Apply отбирает, сортирует и ограничивает списки снимка. Списки отключенных метрик не изменяются.
+func (s *Set) Apply(snapshot *pb.Snapshot) {
+	if s == nil {
+		return
+	}
+	snapshot.NetConnections = s.connections.apply(snapshot.NetConnections)
+	snapshot.NetTopByConnection = s.topByConnection.apply(snapshot.NetTopByConnection)
+	snapshot.DiskUsage = s.diskUsage.apply(snapshot.DiskUsage)
+	snapshot.DiskIO = s.diskIO.apply(snapshot.DiskIO)
+}
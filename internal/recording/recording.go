@@ -0,0 +1,72 @@
+package recording
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Writer записывает снимки потока в файл. Каждая запись сбрасывается на диск сразу,
+// чтобы при аварийном завершении в файле остались все полученные снимки.
+type Writer struct {
+	f *os.File
+	w *bufio.Writer
+}
+
+// Create создает файл записи, существующий файл перезаписывается.
+func Create(path string) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Write записывает снимок, полученный в момент at.
+func (w *Writer) Write(snapshot *pb.Snapshot, at time.Time) error {
+	if _, err := protodelim.MarshalTo(w.w, &pb.Record{Time: at.UnixMilli(), Snapshot: snapshot}); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+func (w *Writer) Close() error {
+	return errors.Join(w.w.Flush(), w.f.Close())
+}
+
+// Reader читает записи из файла в порядке записи.
+type Reader struct {
+	f *os.File
+	r *bufio.Reader
+}
+
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{f: f, r: bufio.NewReader(f)}, nil
+}
+
+// Read возвращает следующую запись или io.EOF в конце файла. Запись, оборванная
+// при аварийном завершении, считается концом файла.
+func (r *Reader) Read() (*pb.Record, error) {
+	result := &pb.Record{}
+	err := protodelim.UnmarshalFrom(r.r, result)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
@@ -0,0 +1,56 @@
+package recording
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stream.rec")
+	start := time.UnixMilli(time.Now().UnixMilli())
+
+	w, err := Create(path)
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		snapshot := &pb.Snapshot{Seq: uint64(i), LoadAvg: &pb.LoadAverage{One: float64(i)}}
+		require.NoError(t, w.Write(snapshot, start.Add(time.Duration(i)*time.Second)))
+	}
+	require.NoError(t, w.Close())
+
+	t.Run("read", func(t *testing.T) {
+		r, err := Open(path)
+		require.NoError(t, err)
+		defer r.Close()
+		for i := 1; i <= 3; i++ {
+			record, err := r.Read()
+			require.NoError(t, err)
+			require.Equal(t, start.Add(time.Duration(i)*time.Second).UnixMilli(), record.Time)
+			require.Equal(t, uint64(i), record.Snapshot.Seq)
+			require.Equal(t, float64(i), record.Snapshot.LoadAvg.One)
+		}
+		_, err = r.Read()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("truncated record", func(t *testing.T) {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, info.Size()-2))
+
+		r, err := Open(path)
+		require.NoError(t, err)
+		defer r.Close()
+		for i := 1; i <= 2; i++ {
+			_, err = r.Read()
+			require.NoError(t, err)
+		}
+		_, err = r.Read()
+		require.ErrorIs(t, err, io.EOF)
+	})
+}
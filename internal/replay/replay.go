@@ -0,0 +1,189 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/skushnerchuk/simda/internal/delta"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/query"
+	"github.com/skushnerchuk/simda/internal/recording"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrEmptyRecording возвращается, если в файле записи нет ни одного снимка.
+var ErrEmptyRecording = errors.New("recording has no snapshots")
+
+// Server отдает записанный поток снимков через сервис Simda вместо демона. Каждый клиент получает
+// запись с начала, паузы между снимками равны паузам при записи, деленным на speed. Окно из запроса
+// клиента не используется, период - только для повтора записи из одного снимка. Изменения списков
+// и запросы к спискам поддерживаются.
+type Server struct {
+	pb.UnimplementedSimdaServer
+	path      string
+	address   string
+	speed     float64
+	loop      bool
+	logger    logger.Logger
+	validator *protovalidate.Validator
+	server    *grpc.Server
+	serverCtx context.Context
+}
+
+// NewServer создает сервер. Если loop установлен, по окончании записи она воспроизводится заново
+// после паузы, равной интервалу между последними снимками записи.
+func NewServer(path, address string, speed float64, loop bool, l logger.Logger) *Server {
+	v, _ := protovalidate.New()
+	return &Server{
+		path:      path,
+		address:   address,
+		speed:     speed,
+		loop:      loop,
+		logger:    l,
+		validator: v,
+	}
+}
+
+// Start проверяет, что файл записи доступен и содержит снимки, и запускает gRPC-сервер.
+func (s *Server) Start(ctx context.Context) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+
+	s.serverCtx = ctx
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.server = grpc.NewServer()
+	pb.RegisterSimdaServer(s.server, s)
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			s.logger.Error(err.Error())
+		}
+	}()
+	s.logger.Info("replay server started", "grpc", s.address, "file", s.path, "speed", s.speed)
+	return nil
+}
+
+// check ищет в файле записи первый снимок.
+func (s *Server) check() error {
+	r, err := recording.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%s: %w", s.path, ErrEmptyRecording)
+		}
+		if err != nil {
+			return err
+		}
+		if record.Snapshot != nil {
+			return nil
+		}
+	}
+}
+
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+func (s *Server) StreamSnapshots(r *pb.Request, srv pb.Simda_StreamSnapshotsServer) error {
+	if err := s.validator.Validate(r); err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	queries, err := query.New(r.Queries)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	var encoder *delta.Encoder
+	if r.Delta {
+		encoder = delta.NewEncoder(r.Resync, queries)
+	}
+	s.logger.Debug("Client connected to replay")
+
+	seq := uint64(0)
+	send := func(snapshot *pb.Snapshot) error {
+		// Номера снимков в воспроизведении идут подряд, в том числе после повтора записи
+		seq++
+		snapshot.Seq = seq
+		queries.Apply(snapshot)
+		if encoder != nil {
+			encoder.Encode(snapshot)
+		}
+		return srv.Send(snapshot)
+	}
+	// Если в записи один снимок, перед повтором выдерживается период из запроса клиента, но не меньше секунды
+	period := max(time.Duration(r.Period)*time.Second, time.Second)
+	for {
+		played, err := s.play(srv.Context(), send)
+		if err != nil || !s.loop {
+			return err
+		}
+		if played > 0 {
+			period = played
+		}
+		if !s.wait(srv.Context(), period) {
+			return nil
+		}
+	}
+}
+
+// wait выдерживает паузу d с учетом скорости воспроизведения. Возвращает false, если клиент
+// отключился или сервер остановлен.
+func (s *Server) wait(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-s.serverCtx.Done():
+		return false
+	case <-time.After(time.Duration(float64(d) / s.speed)):
+		return true
+	}
+}
+
+// play передает снимки записи в send с паузами между ними до конца файла или отключения клиента.
+// Возвращает последний интервал между снимками записи или 0, если интервалов не было.
+func (s *Server) play(ctx context.Context, send func(*pb.Snapshot) error) (time.Duration, error) {
+	reader, err := recording.Open(s.path)
+	if err != nil {
+		return 0, status.Errorf(codes.Unavailable, "failed to open recording: %s", err.Error())
+	}
+	defer reader.Close()
+
+	prev := int64(0)
+	period := time.Duration(0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return period, nil
+		}
+		if err != nil {
+			return 0, status.Errorf(codes.DataLoss, "failed to read recording: %s", err.Error())
+		}
+		if prev != 0 && record.Time > prev {
+			period = time.Duration(record.Time-prev) * time.Millisecond
+			if !s.wait(ctx, period) {
+				return 0, nil
+			}
+		}
+		prev = record.Time
+		if record.Snapshot == nil {
+			continue
+		}
+		if err = send(record.Snapshot); err != nil {
+			return 0, fmt.Errorf("failed to send snapshot: %w", err)
+		}
+	}
+}
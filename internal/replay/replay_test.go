@@ -0,0 +1,128 @@
+package replay
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/delta"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/recording"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// record создает запись из count снимков с интервалом в 1 секунду.
+func record(t *testing.T, count int) string {
+	path := filepath.Join(t.TempDir(), "stream.rec")
+	w, err := recording.Create(path)
+	require.NoError(t, err)
+	start := time.Now()
+	for i := 1; i <= count; i++ {
+		snapshot := &pb.Snapshot{
+			Seq:     uint64(i * 10),
+			LoadAvg: &pb.LoadAverage{One: float64(i)},
+			DiskIO:  []*pb.DiskIO{{Name: "sda", Tps: float64(i)}},
+		}
+		require.NoError(t, w.Write(snapshot, start.Add(time.Duration(i)*time.Second)))
+	}
+	require.NoError(t, w.Close())
+	return path
+}
+
+func dial(t *testing.T, s *Server) pb.SimdaClient {
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterSimdaServer(srv, s)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewSimdaClient(conn)
+}
+
+func TestReplay(t *testing.T) {
+	log := logger.NewSLogger(os.Stdout, "DEBUG")
+	log.Disable()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("accelerated", func(t *testing.T) {
+		s := NewServer(record(t, 3), "", 20, false, log)
+		s.serverCtx = ctx
+		stream, err := dial(t, s).StreamSnapshots(ctx, &pb.Request{Period: 1, Warming: 1})
+		require.NoError(t, err)
+
+		started := time.Now()
+		for i := 1; i <= 3; i++ {
+			snapshot, err := stream.Recv()
+			require.NoError(t, err)
+			require.Equal(t, uint64(i), snapshot.Seq)
+			require.Equal(t, float64(i), snapshot.LoadAvg.One)
+		}
+		// Две паузы по секунде при скорости 20 занимают 100 мс
+		require.GreaterOrEqual(t, time.Since(started), 90*time.Millisecond)
+		require.Less(t, time.Since(started), time.Second)
+		_, err = stream.Recv()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("loop with delta", func(t *testing.T) {
+		s := NewServer(record(t, 3), "", 100, true, log)
+		s.serverCtx = ctx
+		stream, err := dial(t, s).StreamSnapshots(ctx, &pb.Request{Period: 1, Warming: 1, Delta: true})
+		require.NoError(t, err)
+
+		decoder := delta.NewDecoder()
+		received := make([]time.Time, 0, 6)
+		for i := 1; i <= 6; i++ {
+			snapshot, err := stream.Recv()
+			require.NoError(t, err)
+			received = append(received, time.Now())
+			require.NoError(t, decoder.Decode(snapshot))
+			require.Equal(t, uint64(i), snapshot.Seq)
+			require.Equal(t, float64((i-1)%3+1), snapshot.DiskIO[0].Tps)
+		}
+		// Перед повтором выдерживается интервал записи: 1 секунда при скорости 100
+		require.GreaterOrEqual(t, received[3].Sub(received[2]), 9*time.Millisecond)
+	})
+
+	t.Run("loop single snapshot", func(t *testing.T) {
+		s := NewServer(record(t, 1), "", 20, true, log)
+		s.serverCtx = ctx
+		stream, err := dial(t, s).StreamSnapshots(ctx, &pb.Request{Period: 2, Warming: 2})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		require.NoError(t, err)
+		started := time.Now()
+		snapshot, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), snapshot.Seq)
+		// Интервала в записи нет, используется период запроса: 2 секунды при скорости 20
+		require.GreaterOrEqual(t, time.Since(started), 90*time.Millisecond)
+	})
+
+	t.Run("empty recording", func(t *testing.T) {
+		s := NewServer(record(t, 0), "127.0.0.1:0", 1, true, log)
+		require.ErrorIs(t, s.Start(ctx), ErrEmptyRecording)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		s := NewServer(filepath.Join(t.TempDir(), "missing.rec"), "127.0.0.1:0", 1, false, log)
+		require.Error(t, s.Start(ctx))
+	})
+}
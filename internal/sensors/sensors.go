@@ -0,0 +1,36 @@
+package sensors
+
+import (
+	"encoding/json"
+)
+
+const (
+	KindTemperature = "temperature"
+	KindFan         = "fan"
+	KindVoltage     = "voltage"
+)
+
+// Sensor - показания одного датчика. Температура в градусах Цельсия, вентиляторы в об/мин,
+// напряжение в вольтах. Нулевые Max и Critical означают, что порог не задан.
+type Sensor struct {
+	// Source - путь к датчику относительно каталога класса, уникален в пределах хоста
+	Source   string
+	Chip     string
+	Label    string
+	Kind     string
+	Value    float64
+	Max      float64
+	Critical float64
+}
+
+type Stat []Sensor
+
+func (s Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan Stat, error)
+	Get() (Stat, error)
+}
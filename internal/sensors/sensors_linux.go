@@ -0,0 +1,211 @@
+//go:build linux
+
+package sensors
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+)
+
+var (
+	ErrNoSensorClasses = errors.New("neither hwmon nor thermal class found in sysfs")
+
+	hwmonInput = regexp.MustCompile(`^(temp|fan|in)(\d+)_input$`)
+)
+
+// Значения hwmon и thermal хранятся в тысячных долях единицы, кроме оборотов вентиляторов.
+var hwmonScale = map[string]float64{"temp": 1000, "fan": 1, "in": 1000}
+
+var hwmonKind = map[string]string{"temp": KindTemperature, "fan": KindFan, "in": KindVoltage}
+
+type LinuxSensorsCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+}
+
+func NewLinuxSensorsCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxSensorsCollector {
+	return &LinuxSensorsCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxSensorsCollector) Run() (<-chan Stat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("sensors collector error", "error", err.Error())
+		l.cfg.Metrics.Sensors = false
+		return nil, err
+	}
+	ch := make(chan Stat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorSensors))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("sensors collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.Sensors {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("sensors collector error", "error", err.Error())
+					l.cfg.Metrics.Sensors = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (l *LinuxSensorsCollector) Get() (Stat, error) {
+	classRoot := filepath.Join(l.cfg.System.Sys, "class")
+	hwmon, hwmonErr := l.readHwmon(filepath.Join(classRoot, "hwmon"))
+	thermal, thermalErr := l.readThermal(filepath.Join(classRoot, "thermal"))
+	if hwmonErr != nil && thermalErr != nil {
+		return nil, ErrNoSensorClasses
+	}
+	result := append(hwmon, thermal...)
+	sort.Slice(result, func(i, j int) bool { return result[i].Source < result[j].Source })
+	return result, nil
+}
+
+func readValue(path string) (float64, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(string(b)), 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+func readString(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func (l *LinuxSensorsCollector) readHwmon(root string) (Stat, error) {
+	chips, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	result := make(Stat, 0)
+	for _, chip := range chips {
+		dir := filepath.Join(root, chip.Name())
+		name := readString(filepath.Join(dir, "name"))
+		if name == "" {
+			name = chip.Name()
+		}
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, f := range files {
+			m := hwmonInput.FindStringSubmatch(f.Name())
+			if m == nil {
+				continue
+			}
+			prefix := m[1] + m[2]
+			value, ok := readValue(filepath.Join(dir, f.Name()))
+			if !ok {
+				// Датчик может быть отключен, чтение в этом случае возвращает ошибку
+				continue
+			}
+			scale := hwmonScale[m[1]]
+			s := Sensor{
+				Source: filepath.Join("hwmon", chip.Name(), prefix),
+				Chip:   name,
+				Label:  readString(filepath.Join(dir, prefix+"_label")),
+				Kind:   hwmonKind[m[1]],
+				Value:  value / scale,
+			}
+			if s.Label == "" {
+				s.Label = prefix
+			}
+			if v, ok := readValue(filepath.Join(dir, prefix+"_max")); ok {
+				s.Max = v / scale
+			}
+			if v, ok := readValue(filepath.Join(dir, prefix+"_crit")); ok {
+				s.Critical = v / scale
+			}
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (l *LinuxSensorsCollector) readThermal(root string) (Stat, error) {
+	zones, err := filepath.Glob(filepath.Join(root, "thermal_zone*"))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+	result := make(Stat, 0)
+	for _, dir := range zones {
+		value, ok := readValue(filepath.Join(dir, "temp"))
+		if !ok {
+			continue
+		}
+		s := Sensor{
+			Source: filepath.Join("thermal", filepath.Base(dir)),
+			Chip:   "thermal",
+			Label:  readString(filepath.Join(dir, "type")),
+			Kind:   KindTemperature,
+			Value:  value / hwmonScale["temp"],
+		}
+		if s.Label == "" {
+			s.Label = filepath.Base(dir)
+		}
+		trips, _ := filepath.Glob(filepath.Join(dir, "trip_point_*_type"))
+		for _, trip := range trips {
+			t, ok := readValue(strings.TrimSuffix(trip, "_type") + "_temp")
+			if !ok {
+				continue
+			}
+			t /= hwmonScale["temp"]
+			// Порог hot приоритетнее passive, при котором начинается троттлинг
+			switch readString(trip) {
+			case "critical":
+				s.Critical = t
+			case "hot":
+				s.Max = t
+			case "passive":
+				if s.Max == 0 {
+					s.Max = t
+				}
+			}
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+//go:build linux
+
+package sensors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+func createConfig(sys string) *config.DaemonConfig {
+	return &config.DaemonConfig{
+		System:  config.SystemPoints{Sys: sys},
+		Metrics: config.Metrics{Sensors: true},
+	}
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content+"\n"), 0o600))
+	}
+}
+
+func fakeSysfs(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"class/hwmon/hwmon0/name":        "coretemp",
+		"class/hwmon/hwmon0/temp1_input": "45000",
+		"class/hwmon/hwmon0/temp1_label": "Package id 0",
+		"class/hwmon/hwmon0/temp1_max":   "80000",
+		"class/hwmon/hwmon0/temp1_crit":  "100000",
+		"class/hwmon/hwmon0/temp2_input": "42500",
+
+		"class/hwmon/hwmon1/name":        "nct6775",
+		"class/hwmon/hwmon1/fan1_input":  "1200",
+		"class/hwmon/hwmon1/fan1_label":  "CPU fan",
+		"class/hwmon/hwmon1/in0_input":   "1104",
+		"class/hwmon/hwmon1/in0_max":     "1744",
+		"class/hwmon/hwmon1/fan2_enable": "0",
+
+		"class/thermal/thermal_zone0/type":              "x86_pkg_temp",
+		"class/thermal/thermal_zone0/temp":              "47000",
+		"class/thermal/thermal_zone0/trip_point_0_type": "passive",
+		"class/thermal/thermal_zone0/trip_point_0_temp": "95000",
+		"class/thermal/thermal_zone0/trip_point_1_type": "critical",
+		"class/thermal/thermal_zone0/trip_point_1_temp": "105000",
+	})
+	return root
+}
+
+func TestSensors(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("sensors: Get() ok", func(t *testing.T) {
+		v := NewLinuxSensorsCollector(context.TODO(), context.TODO(), createConfig(fakeSysfs(t)), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Equal(t, Stat{
+			{
+				Source: "hwmon/hwmon0/temp1", Chip: "coretemp", Label: "Package id 0", Kind: KindTemperature,
+				Value: 45, Max: 80, Critical: 100,
+			},
+			{Source: "hwmon/hwmon0/temp2", Chip: "coretemp", Label: "temp2", Kind: KindTemperature, Value: 42.5},
+			{Source: "hwmon/hwmon1/fan1", Chip: "nct6775", Label: "CPU fan", Kind: KindFan, Value: 1200},
+			{Source: "hwmon/hwmon1/in0", Chip: "nct6775", Label: "in0", Kind: KindVoltage, Value: 1.104, Max: 1.744},
+			{
+				Source: "thermal/thermal_zone0", Chip: "thermal", Label: "x86_pkg_temp", Kind: KindTemperature,
+				Value: 47, Max: 95, Critical: 105,
+			},
+		}, val)
+	})
+
+	t.Run("sensors: only thermal zones", func(t *testing.T) {
+		root := t.TempDir()
+		writeFiles(t, root, map[string]string{"class/thermal/thermal_zone3/temp": "30000"})
+		v := NewLinuxSensorsCollector(context.TODO(), context.TODO(), createConfig(root), log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		require.Len(t, val, 1)
+		require.Equal(t, "thermal_zone3", val[0].Label)
+	})
+
+	t.Run("sensors: Run() error", func(t *testing.T) {
+		cfg := createConfig(t.TempDir())
+		v := NewLinuxSensorsCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := v.Run()
+		require.Nil(t, ch)
+		require.ErrorIs(t, err, ErrNoSensorClasses)
+		require.False(t, cfg.Metrics.Sensors)
+	})
+
+	t.Run("sensors: metric enabled", func(t *testing.T) {
+		cfg := createConfig(fakeSysfs(t))
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		v := NewLinuxSensorsCollector(ctx, ctx, cfg, log)
+
+		ch, err := v.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.Len(t, val, 5)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.Sensors)
+	})
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/alert"
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// startAlerts запускает проверку правил оповещений по снимкам фонового потока,
+// который собирает только метрики из правил.
+func (s *SimdaServer) startAlerts() error {
+	if len(s.cfg.Alerts.Rules) == 0 {
+		return nil
+	}
+	engine, err := alert.NewEngine(s.cfg.Alerts.Rules)
+	if err != nil {
+		return err
+	}
+	notifier := alert.NewNotifier(s.cfg.Alerts, s.cfg.HostName(), s.logger)
+	go notifier.Run(s.serverCtx)
+	s.startBackground("alerts evaluation", s.cfg.Alerts.Interval, engine.Collectors(), func(snapshot *pb.Snapshot) {
+		changes := engine.Evaluate(snapshot, time.UnixMilli(snapshot.WindowEnd))
+		for _, a := range changes {
+			s.logger.Info("Alert "+a.State, "rule", a.Rule, "labels", a.Labels, "value", a.Value)
+		}
+		notifier.Notify(changes)
+	})
+	s.alerts = engine
+	return nil
+}
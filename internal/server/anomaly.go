@@ -0,0 +1,20 @@
+package server
+
+import (
+	"github.com/skushnerchuk/simda/internal/anomaly"
+)
+
+// startAnomaly запускает обучение базовых линий метрик по снимкам фонового потока,
+// который собирает только метрики, для которых включено обнаружение аномалий.
+func (s *SimdaServer) startAnomaly() error {
+	if len(s.cfg.Anomaly.Metrics) == 0 {
+		return nil
+	}
+	detector, err := anomaly.New(s.cfg.Anomaly)
+	if err != nil {
+		return err
+	}
+	s.startBackground("anomaly learning", s.cfg.Anomaly.Interval, detector.Collectors(), detector.Learn)
+	s.anomalies = detector
+	return nil
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"time"
+
+	pb "github.com/skushnerchuk/simda/internal/server/gen"
+)
+
+// startBackground запускает поток снимков с окном interval, который работает независимо от подключенных
+// клиентов и собирает только метрики сборщиков collectors. Каждый снимок передается в handle.
+func (s *SimdaServer) startBackground(
+	name string, interval time.Duration, collectors []string, handle func(*pb.Snapshot),
+) {
+	seconds := uint32(interval / time.Second)
+	request := &pb.Request{Period: seconds, Warming: seconds}
+	streamer := NewSnapshotStreamer(s.serverCtx, s.serverCtx, request, s.logger, s.cfg, s.shared(), nil, nil, nil)
+	streamer.env.Collectors = collectors
+	go func() {
+		for snapshot := range streamer.Stream() {
+			handle(snapshot)
+		}
+		s.logger.Debug(name + " stopped")
+	}()
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/disk/forecast"
+)
+
+// Шаг, с которым сохраняется история использования файловых систем.
+const diskHistoryResolution = 30 * time.Second
+
+func newDiskHistory(cfg *config.DaemonConfig) *forecast.History {
+	maxAge := time.Duration(0)
+	for _, w := range cfg.Forecast.Windows {
+		if w > maxAge {
+			maxAge = w
+		}
+	}
+	return forecast.NewHistory(maxAge, diskHistoryResolution)
+}
+
+// recordDiskHistory сохраняет измерения сборщика в историю и передает их потокам снимков
+// до закрытия канала.
+func (s *SimdaServer) recordDiskHistory(ch <-chan disk.UsageStatMap) {
+	for stat := range ch {
+		s.diskUsage.Set(stat)
+		now := time.Now()
+		for _, v := range stat {
+			if v.Stale {
+				continue
+			}
+			s.diskHistory.Add(v.Mountpoint, forecast.Sample{
+				Time:       now,
+				Used:       v.Used,
+				Free:       v.Free,
+				INodesUsed: v.INodeUsed,
+				INodesFree: v.INodeFree,
+			})
+		}
+	}
+	s.logger.Debug("disk history collector stopped")
+}
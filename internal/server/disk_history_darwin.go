@@ -0,0 +1,5 @@
+//go:build darwin
+
+package server
+
+func (s *SimdaServer) startDiskHistory() {}
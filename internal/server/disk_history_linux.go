@@ -0,0 +1,21 @@
+//go:build linux
+
+package server
+
+import (
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/disk/diskusage"
+)
+
+// startDiskHistory запускает общий сборщик использования файловых систем, который работает независимо
+// от подключенных клиентов. Его измерения сохраняются в историю и передаются потокам снимков.
+func (s *SimdaServer) startDiskHistory() {
+	c := diskusage.NewLinuxDiskUsageCollector(s.serverCtx, s.serverCtx, s.cfg, s.logger)
+	ch, err := c.Run()
+	if err != nil {
+		s.logger.Error("Failed to create disk history collector, forecast disabled", "error", err.Error())
+		return
+	}
+	s.diskUsage = &disk.UsageFeed{}
+	go s.recordDiskHistory(ch)
+}
@@ -21,17 +21,23 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Request struct {
+// Запрос к списку снимка. target - netConnections, netTopByConnection, diskUsage или diskIO.
+// filter - условия вида "state=ESTABLISHED and port=443", операции =, !=, >, <, >=, <=.
+// orderBy - поле сортировки, desc - сортировка по убыванию, limit - наибольшее число элементов (0 - без ограничения)
+type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Period  uint32 `protobuf:"varint,1,opt,name=period,proto3" json:"period"`
-	Warming uint32 `protobuf:"varint,2,opt,name=warming,proto3" json:"warming"`
+	Target  string `protobuf:"bytes,1,opt,name=target,proto3" json:"target"`
+	Filter  string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	OrderBy string `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy"`
+	Desc    bool   `protobuf:"varint,4,opt,name=desc,proto3" json:"desc"`
+	Limit   uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
+func (x *Query) Reset() {
+	*x = Query{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -39,13 +45,13 @@ func (x *Request) Reset() {
 	}
 }
 
-func (x *Request) String() string {
+func (x *Query) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*Query) ProtoMessage() {}
 
-func (x *Request) ProtoReflect() protoreflect.Message {
+func (x *Query) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -57,38 +63,65 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Request) GetPeriod() uint32 {
+func (x *Query) GetTarget() string {
 	if x != nil {
-		return x.Period
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *Request) GetWarming() uint32 {
+func (x *Query) GetFilter() string {
 	if x != nil {
-		return x.Warming
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *Query) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *Query) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *Query) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-// Загрузка системы
-type LoadAverage struct {
+type Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	One     float64 `protobuf:"fixed64,1,opt,name=one,proto3" json:"one"`
-	Five    float64 `protobuf:"fixed64,2,opt,name=five,proto3" json:"five"`
-	Fifteen float64 `protobuf:"fixed64,15,opt,name=fifteen,proto3" json:"fifteen"`
+	Period  uint32 `protobuf:"varint,1,opt,name=period,proto3" json:"period"`
+	Warming uint32 `protobuf:"varint,2,opt,name=warming,proto3" json:"warming"`
+	// Функции агрегации значений за окно прогрева. Если список пуст, используются функции из настроек демона
+	Aggregations []string `protobuf:"bytes,3,rep,name=aggregations,proto3" json:"aggregations"`
+	// Передавать вместо списков соединений, файловых систем и дисков только их изменения.
+	// Каждое resync-е сообщение содержит полный снимок, 0 - значение по умолчанию
+	Delta  bool   `protobuf:"varint,4,opt,name=delta,proto3" json:"delta"`
+	Resync uint32 `protobuf:"varint,5,opt,name=resync,proto3" json:"resync"`
+	// Отбор, сортировка и ограничение списков снимка на стороне сервера, не больше одного запроса на список
+	Queries []*Query `protobuf:"bytes,6,rep,name=queries,proto3" json:"queries"`
 }
 
-func (x *LoadAverage) Reset() {
-	*x = LoadAverage{}
+func (x *Request) Reset() {
+	*x = Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -96,13 +129,13 @@ func (x *LoadAverage) Reset() {
 	}
 }
 
-func (x *LoadAverage) String() string {
+func (x *Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadAverage) ProtoMessage() {}
+func (*Request) ProtoMessage() {}
 
-func (x *LoadAverage) ProtoReflect() protoreflect.Message {
+func (x *Request) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -114,45 +147,71 @@ func (x *LoadAverage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadAverage.ProtoReflect.Descriptor instead.
-func (*LoadAverage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *LoadAverage) GetOne() float64 {
+func (x *Request) GetPeriod() uint32 {
 	if x != nil {
-		return x.One
+		return x.Period
 	}
 	return 0
 }
 
-func (x *LoadAverage) GetFive() float64 {
+func (x *Request) GetWarming() uint32 {
 	if x != nil {
-		return x.Five
+		return x.Warming
 	}
 	return 0
 }
 
-func (x *LoadAverage) GetFifteen() float64 {
+func (x *Request) GetAggregations() []string {
 	if x != nil {
-		return x.Fifteen
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *Request) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *Request) GetResync() uint32 {
+	if x != nil {
+		return x.Resync
 	}
 	return 0
 }
 
-// Загрузка процессора
-type CpuAverage struct {
+func (x *Request) GetQueries() []*Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+// Статистика значения за окно прогрева. Заполняются только запрошенные функции агрегации
+type Aggregates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User   float64 `protobuf:"fixed64,1,opt,name=user,proto3" json:"user"`
-	System float64 `protobuf:"fixed64,2,opt,name=system,proto3" json:"system"`
-	Idle   float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle"`
+	Mean   *float64 `protobuf:"fixed64,1,opt,name=mean,proto3,oneof" json:"mean"`
+	Min    *float64 `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min"`
+	Max    *float64 `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max"`
+	Last   *float64 `protobuf:"fixed64,4,opt,name=last,proto3,oneof" json:"last"`
+	P50    *float64 `protobuf:"fixed64,5,opt,name=p50,proto3,oneof" json:"p50"`
+	P95    *float64 `protobuf:"fixed64,6,opt,name=p95,proto3,oneof" json:"p95"`
+	P99    *float64 `protobuf:"fixed64,7,opt,name=p99,proto3,oneof" json:"p99"`
+	Stddev *float64 `protobuf:"fixed64,8,opt,name=stddev,proto3,oneof" json:"stddev"`
 }
 
-func (x *CpuAverage) Reset() {
-	*x = CpuAverage{}
+func (x *Aggregates) Reset() {
+	*x = Aggregates{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,13 +219,13 @@ func (x *CpuAverage) Reset() {
 	}
 }
 
-func (x *CpuAverage) String() string {
+func (x *Aggregates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CpuAverage) ProtoMessage() {}
+func (*Aggregates) ProtoMessage() {}
 
-func (x *CpuAverage) ProtoReflect() protoreflect.Message {
+func (x *Aggregates) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,46 +237,82 @@ func (x *CpuAverage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CpuAverage.ProtoReflect.Descriptor instead.
-func (*CpuAverage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Aggregates.ProtoReflect.Descriptor instead.
+func (*Aggregates) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CpuAverage) GetUser() float64 {
-	if x != nil {
-		return x.User
+func (x *Aggregates) GetMean() float64 {
+	if x != nil && x.Mean != nil {
+		return *x.Mean
 	}
 	return 0
 }
 
-func (x *CpuAverage) GetSystem() float64 {
-	if x != nil {
-		return x.System
+func (x *Aggregates) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
 	return 0
 }
 
-func (x *CpuAverage) GetIdle() float64 {
-	if x != nil {
-		return x.Idle
+func (x *Aggregates) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
 
-// Сведения о дисках (i/o)
-type DiskIO struct {
+func (x *Aggregates) GetLast() float64 {
+	if x != nil && x.Last != nil {
+		return *x.Last
+	}
+	return 0
+}
+
+func (x *Aggregates) GetP50() float64 {
+	if x != nil && x.P50 != nil {
+		return *x.P50
+	}
+	return 0
+}
+
+func (x *Aggregates) GetP95() float64 {
+	if x != nil && x.P95 != nil {
+		return *x.P95
+	}
+	return 0
+}
+
+func (x *Aggregates) GetP99() float64 {
+	if x != nil && x.P99 != nil {
+		return *x.P99
+	}
+	return 0
+}
+
+func (x *Aggregates) GetStddev() float64 {
+	if x != nil && x.Stddev != nil {
+		return *x.Stddev
+	}
+	return 0
+}
+
+// Оценка значения поля метрики детектором аномалий. baseline - ожидаемое значение, score - отклонение
+// от него в единицах разброса, обученного на измерениях этого узла. anomalous - оценка превысила порог детектора
+type AnomalyScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	Tps     float64 `protobuf:"fixed64,2,opt,name=tps,proto3" json:"tps"`
-	RdSpeed float64 `protobuf:"fixed64,3,opt,name=rdSpeed,proto3" json:"rdSpeed"`
-	WrSpeed float64 `protobuf:"fixed64,4,opt,name=wrSpeed,proto3" json:"wrSpeed"`
+	Field     string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Baseline  float64 `protobuf:"fixed64,2,opt,name=baseline,proto3" json:"baseline"`
+	Score     float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score"`
+	Anomalous bool    `protobuf:"varint,4,opt,name=anomalous,proto3" json:"anomalous"`
 }
 
-func (x *DiskIO) Reset() {
-	*x = DiskIO{}
+func (x *AnomalyScore) Reset() {
+	*x = AnomalyScore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,13 +320,13 @@ func (x *DiskIO) Reset() {
 	}
 }
 
-func (x *DiskIO) String() string {
+func (x *AnomalyScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskIO) ProtoMessage() {}
+func (*AnomalyScore) ProtoMessage() {}
 
-func (x *DiskIO) ProtoReflect() protoreflect.Message {
+func (x *AnomalyScore) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,55 +338,59 @@ func (x *DiskIO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskIO.ProtoReflect.Descriptor instead.
-func (*DiskIO) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnomalyScore.ProtoReflect.Descriptor instead.
+func (*AnomalyScore) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DiskIO) GetName() string {
+func (x *AnomalyScore) GetField() string {
 	if x != nil {
-		return x.Name
+		return x.Field
 	}
 	return ""
 }
 
-func (x *DiskIO) GetTps() float64 {
+func (x *AnomalyScore) GetBaseline() float64 {
 	if x != nil {
-		return x.Tps
+		return x.Baseline
 	}
 	return 0
 }
 
-func (x *DiskIO) GetRdSpeed() float64 {
+func (x *AnomalyScore) GetScore() float64 {
 	if x != nil {
-		return x.RdSpeed
+		return x.Score
 	}
 	return 0
 }
 
-func (x *DiskIO) GetWrSpeed() float64 {
+func (x *AnomalyScore) GetAnomalous() bool {
 	if x != nil {
-		return x.WrSpeed
+		return x.Anomalous
 	}
-	return 0
+	return false
 }
 
-// Сведения о дисках (usage)
-type DiskUsage struct {
+// Загрузка системы. runningTasks - исполняемые задачи, totalThreads - всего потоков в системе
+type LoadAverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Device                string  `protobuf:"bytes,1,opt,name=device,proto3" json:"device"`
-	MountPoint            string  `protobuf:"bytes,2,opt,name=mountPoint,proto3" json:"mountPoint"`
-	UsagePercent          float64 `protobuf:"fixed64,3,opt,name=usagePercent,proto3" json:"usagePercent"`
-	Usage                 float64 `protobuf:"fixed64,4,opt,name=usage,proto3" json:"usage"`
-	InodeCount            float64 `protobuf:"fixed64,5,opt,name=inodeCount,proto3" json:"inodeCount"`
-	InodeAvailablePercent float64 `protobuf:"fixed64,6,opt,name=inodeAvailablePercent,proto3" json:"inodeAvailablePercent"`
+	One          float64         `protobuf:"fixed64,1,opt,name=one,proto3" json:"one"`
+	Five         float64         `protobuf:"fixed64,2,opt,name=five,proto3" json:"five"`
+	Fifteen      float64         `protobuf:"fixed64,15,opt,name=fifteen,proto3" json:"fifteen"`
+	RunningTasks uint64          `protobuf:"varint,3,opt,name=runningTasks,proto3" json:"runningTasks"`
+	TotalThreads uint64          `protobuf:"varint,4,opt,name=totalThreads,proto3" json:"totalThreads"`
+	LastPid      uint64          `protobuf:"varint,5,opt,name=lastPid,proto3" json:"lastPid"`
+	OneStats     *Aggregates     `protobuf:"bytes,6,opt,name=oneStats,proto3" json:"oneStats"`
+	FiveStats    *Aggregates     `protobuf:"bytes,7,opt,name=fiveStats,proto3" json:"fiveStats"`
+	FifteenStats *Aggregates     `protobuf:"bytes,8,opt,name=fifteenStats,proto3" json:"fifteenStats"`
+	Anomalies    []*AnomalyScore `protobuf:"bytes,9,rep,name=anomalies,proto3" json:"anomalies"`
 }
 
-func (x *DiskUsage) Reset() {
-	*x = DiskUsage{}
+func (x *LoadAverage) Reset() {
+	*x = LoadAverage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,13 +398,13 @@ func (x *DiskUsage) Reset() {
 	}
 }
 
-func (x *DiskUsage) String() string {
+func (x *LoadAverage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskUsage) ProtoMessage() {}
+func (*LoadAverage) ProtoMessage() {}
 
-func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+func (x *LoadAverage) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,65 +416,93 @@ func (x *DiskUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
-func (*DiskUsage) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoadAverage.ProtoReflect.Descriptor instead.
+func (*LoadAverage) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DiskUsage) GetDevice() string {
+func (x *LoadAverage) GetOne() float64 {
 	if x != nil {
-		return x.Device
+		return x.One
 	}
-	return ""
+	return 0
 }
 
-func (x *DiskUsage) GetMountPoint() string {
+func (x *LoadAverage) GetFive() float64 {
 	if x != nil {
-		return x.MountPoint
+		return x.Five
 	}
-	return ""
+	return 0
 }
 
-func (x *DiskUsage) GetUsagePercent() float64 {
+func (x *LoadAverage) GetFifteen() float64 {
 	if x != nil {
-		return x.UsagePercent
+		return x.Fifteen
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetUsage() float64 {
+func (x *LoadAverage) GetRunningTasks() uint64 {
 	if x != nil {
-		return x.Usage
+		return x.RunningTasks
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetInodeCount() float64 {
+func (x *LoadAverage) GetTotalThreads() uint64 {
 	if x != nil {
-		return x.InodeCount
+		return x.TotalThreads
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetInodeAvailablePercent() float64 {
+func (x *LoadAverage) GetLastPid() uint64 {
 	if x != nil {
-		return x.InodeAvailablePercent
+		return x.LastPid
 	}
 	return 0
 }
 
-// Сведения о процессе
-type Process struct {
+func (x *LoadAverage) GetOneStats() *Aggregates {
+	if x != nil {
+		return x.OneStats
+	}
+	return nil
+}
+
+func (x *LoadAverage) GetFiveStats() *Aggregates {
+	if x != nil {
+		return x.FiveStats
+	}
+	return nil
+}
+
+func (x *LoadAverage) GetFifteenStats() *Aggregates {
+	if x != nil {
+		return x.FifteenStats
+	}
+	return nil
+}
+
+func (x *LoadAverage) GetAnomalies() []*AnomalyScore {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+// Скорость обработки отложенных прерываний одного типа в секунду
+type Softirq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pid     uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid"`
-	CmdLine string `protobuf:"bytes,2,opt,name=cmdLine,proto3" json:"cmdLine"`
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate"`
 }
 
-func (x *Process) Reset() {
-	*x = Process{}
+func (x *Softirq) Reset() {
+	*x = Softirq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,13 +510,13 @@ func (x *Process) Reset() {
 	}
 }
 
-func (x *Process) String() string {
+func (x *Softirq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Process) ProtoMessage() {}
+func (*Softirq) ProtoMessage() {}
 
-func (x *Process) ProtoReflect() protoreflect.Message {
+func (x *Softirq) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,37 +528,49 @@ func (x *Process) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Process.ProtoReflect.Descriptor instead.
-func (*Process) Descriptor() ([]byte, []int) {
+// Deprecated: Use Softirq.ProtoReflect.Descriptor instead.
+func (*Softirq) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Process) GetPid() uint32 {
+func (x *Softirq) GetName() string {
 	if x != nil {
-		return x.Pid
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Process) GetCmdLine() string {
+func (x *Softirq) GetRate() float64 {
 	if x != nil {
-		return x.CmdLine
+		return x.Rate
 	}
-	return ""
+	return 0
 }
 
-// Сетевой адрес
-type SockAddr struct {
+// Загрузка процессора и активность ядра. Переключения контекста, прерывания и
+// создание процессов - в секунду, procsRunning и procsBlocked - среднее количество задач
+type CpuAverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip   string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port"`
+	User            float64         `protobuf:"fixed64,1,opt,name=user,proto3" json:"user"`
+	System          float64         `protobuf:"fixed64,2,opt,name=system,proto3" json:"system"`
+	Idle            float64         `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle"`
+	ContextSwitches float64         `protobuf:"fixed64,4,opt,name=contextSwitches,proto3" json:"contextSwitches"`
+	Interrupts      float64         `protobuf:"fixed64,5,opt,name=interrupts,proto3" json:"interrupts"`
+	Forks           float64         `protobuf:"fixed64,6,opt,name=forks,proto3" json:"forks"`
+	ProcsRunning    float64         `protobuf:"fixed64,7,opt,name=procsRunning,proto3" json:"procsRunning"`
+	ProcsBlocked    float64         `protobuf:"fixed64,8,opt,name=procsBlocked,proto3" json:"procsBlocked"`
+	Softirqs        []*Softirq      `protobuf:"bytes,9,rep,name=softirqs,proto3" json:"softirqs"`
+	UserStats       *Aggregates     `protobuf:"bytes,10,opt,name=userStats,proto3" json:"userStats"`
+	SystemStats     *Aggregates     `protobuf:"bytes,11,opt,name=systemStats,proto3" json:"systemStats"`
+	IdleStats       *Aggregates     `protobuf:"bytes,12,opt,name=idleStats,proto3" json:"idleStats"`
+	Anomalies       []*AnomalyScore `protobuf:"bytes,13,rep,name=anomalies,proto3" json:"anomalies"`
 }
 
-func (x *SockAddr) Reset() {
-	*x = SockAddr{}
+func (x *CpuAverage) Reset() {
+	*x = CpuAverage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,13 +578,13 @@ func (x *SockAddr) Reset() {
 	}
 }
 
-func (x *SockAddr) String() string {
+func (x *CpuAverage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SockAddr) ProtoMessage() {}
+func (*CpuAverage) ProtoMessage() {}
 
-func (x *SockAddr) ProtoReflect() protoreflect.Message {
+func (x *CpuAverage) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -457,148 +596,141 @@ func (x *SockAddr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SockAddr.ProtoReflect.Descriptor instead.
-func (*SockAddr) Descriptor() ([]byte, []int) {
+// Deprecated: Use CpuAverage.ProtoReflect.Descriptor instead.
+func (*CpuAverage) Descriptor() ([]byte, []int) {
 	return file_simda_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SockAddr) GetIp() string {
+func (x *CpuAverage) GetUser() float64 {
 	if x != nil {
-		return x.Ip
+		return x.User
 	}
-	return ""
+	return 0
 }
 
-func (x *SockAddr) GetPort() uint32 {
+func (x *CpuAverage) GetSystem() float64 {
 	if x != nil {
-		return x.Port
+		return x.System
 	}
 	return 0
 }
 
-// Сетевые соединения
-type NetConnection struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Protocol    string    `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
-	Process     *Process  `protobuf:"bytes,2,opt,name=process,proto3,oneof" json:"process"`
-	User        string    `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
-	LocalAddr   *SockAddr `protobuf:"bytes,4,opt,name=localAddr,proto3,oneof" json:"localAddr"`
-	ForeignAddr *SockAddr `protobuf:"bytes,5,opt,name=foreignAddr,proto3,oneof" json:"foreignAddr"`
-	State       string    `protobuf:"bytes,6,opt,name=state,proto3" json:"state"`
-	UserId      uint32    `protobuf:"varint,7,opt,name=userId,proto3" json:"userId"`
-}
-
-func (x *NetConnection) Reset() {
-	*x = NetConnection{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_simda_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CpuAverage) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
 	}
+	return 0
 }
 
-func (x *NetConnection) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CpuAverage) GetContextSwitches() float64 {
+	if x != nil {
+		return x.ContextSwitches
+	}
+	return 0
 }
 
-func (*NetConnection) ProtoMessage() {}
-
-func (x *NetConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_simda_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CpuAverage) GetInterrupts() float64 {
+	if x != nil {
+		return x.Interrupts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NetConnection.ProtoReflect.Descriptor instead.
-func (*NetConnection) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{7}
+func (x *CpuAverage) GetForks() float64 {
+	if x != nil {
+		return x.Forks
+	}
+	return 0
 }
 
-func (x *NetConnection) GetProtocol() string {
+func (x *CpuAverage) GetProcsRunning() float64 {
 	if x != nil {
-		return x.Protocol
+		return x.ProcsRunning
 	}
-	return ""
+	return 0
 }
 
-func (x *NetConnection) GetProcess() *Process {
+func (x *CpuAverage) GetProcsBlocked() float64 {
 	if x != nil {
-		return x.Process
+		return x.ProcsBlocked
 	}
-	return nil
+	return 0
 }
 
-func (x *NetConnection) GetUser() string {
+func (x *CpuAverage) GetSoftirqs() []*Softirq {
 	if x != nil {
-		return x.User
+		return x.Softirqs
 	}
-	return ""
+	return nil
 }
 
-func (x *NetConnection) GetLocalAddr() *SockAddr {
+func (x *CpuAverage) GetUserStats() *Aggregates {
 	if x != nil {
-		return x.LocalAddr
+		return x.UserStats
 	}
 	return nil
 }
 
-func (x *NetConnection) GetForeignAddr() *SockAddr {
+func (x *CpuAverage) GetSystemStats() *Aggregates {
 	if x != nil {
-		return x.ForeignAddr
+		return x.SystemStats
 	}
 	return nil
 }
 
-func (x *NetConnection) GetState() string {
+func (x *CpuAverage) GetIdleStats() *Aggregates {
 	if x != nil {
-		return x.State
+		return x.IdleStats
 	}
-	return ""
+	return nil
 }
 
-func (x *NetConnection) GetUserId() uint32 {
+func (x *CpuAverage) GetAnomalies() []*AnomalyScore {
 	if x != nil {
-		return x.UserId
+		return x.Anomalies
 	}
-	return 0
+	return nil
 }
 
-// Сетевые соединения по состояниям
-type NetConnectionStates struct {
+// Сведения о дисках (i/o)
+type DiskIO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
-	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Tps     float64 `protobuf:"fixed64,2,opt,name=tps,proto3" json:"tps"`
+	RdSpeed float64 `protobuf:"fixed64,3,opt,name=rdSpeed,proto3" json:"rdSpeed"`
+	WrSpeed float64 `protobuf:"fixed64,4,opt,name=wrSpeed,proto3" json:"wrSpeed"`
+	// Имя device-mapper (например, vg0-root) или пустая строка
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label"`
+	// disk, part, dm, md, loop
+	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind"`
+	// Физические диски, на которых расположено устройство
+	Disks        []string        `protobuf:"bytes,7,rep,name=disks,proto3" json:"disks"`
+	TpsStats     *Aggregates     `protobuf:"bytes,8,opt,name=tpsStats,proto3" json:"tpsStats"`
+	RdSpeedStats *Aggregates     `protobuf:"bytes,9,opt,name=rdSpeedStats,proto3" json:"rdSpeedStats"`
+	WrSpeedStats *Aggregates     `protobuf:"bytes,10,opt,name=wrSpeedStats,proto3" json:"wrSpeedStats"`
+	Anomalies    []*AnomalyScore `protobuf:"bytes,11,rep,name=anomalies,proto3" json:"anomalies"`
 }
 
-func (x *NetConnectionStates) Reset() {
-	*x = NetConnectionStates{}
+func (x *DiskIO) Reset() {
+	*x = DiskIO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simda_proto_msgTypes[8]
+		mi := &file_simda_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetConnectionStates) String() string {
+func (x *DiskIO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetConnectionStates) ProtoMessage() {}
+func (*DiskIO) ProtoMessage() {}
 
-func (x *NetConnectionStates) ProtoReflect() protoreflect.Message {
-	mi := &file_simda_proto_msgTypes[8]
+func (x *DiskIO) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,119 +741,133 @@ func (x *NetConnectionStates) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetConnectionStates.ProtoReflect.Descriptor instead.
-func (*NetConnectionStates) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DiskIO.ProtoReflect.Descriptor instead.
+func (*DiskIO) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *NetConnectionStates) GetState() string {
+func (x *DiskIO) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NetConnectionStates) GetCount() uint32 {
+func (x *DiskIO) GetTps() float64 {
 	if x != nil {
-		return x.Count
+		return x.Tps
 	}
 	return 0
 }
 
-// Данные траффика по протоколам
-type NetTopByProtocol struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Protocol string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
-	Bytes    uint64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes"`
-	Percent  float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent"`
+func (x *DiskIO) GetRdSpeed() float64 {
+	if x != nil {
+		return x.RdSpeed
+	}
+	return 0
 }
 
-func (x *NetTopByProtocol) Reset() {
-	*x = NetTopByProtocol{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_simda_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DiskIO) GetWrSpeed() float64 {
+	if x != nil {
+		return x.WrSpeed
 	}
+	return 0
 }
 
-func (x *NetTopByProtocol) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DiskIO) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (*NetTopByProtocol) ProtoMessage() {}
+func (x *DiskIO) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
 
-func (x *NetTopByProtocol) ProtoReflect() protoreflect.Message {
-	mi := &file_simda_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DiskIO) GetDisks() []string {
+	if x != nil {
+		return x.Disks
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NetTopByProtocol.ProtoReflect.Descriptor instead.
-func (*NetTopByProtocol) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{9}
+func (x *DiskIO) GetTpsStats() *Aggregates {
+	if x != nil {
+		return x.TpsStats
+	}
+	return nil
 }
 
-func (x *NetTopByProtocol) GetProtocol() string {
+func (x *DiskIO) GetRdSpeedStats() *Aggregates {
 	if x != nil {
-		return x.Protocol
+		return x.RdSpeedStats
 	}
-	return ""
+	return nil
 }
 
-func (x *NetTopByProtocol) GetBytes() uint64 {
+func (x *DiskIO) GetWrSpeedStats() *Aggregates {
 	if x != nil {
-		return x.Bytes
+		return x.WrSpeedStats
 	}
-	return 0
+	return nil
 }
 
-func (x *NetTopByProtocol) GetPercent() float64 {
+func (x *DiskIO) GetAnomalies() []*AnomalyScore {
 	if x != nil {
-		return x.Percent
+		return x.Anomalies
 	}
-	return 0
+	return nil
 }
 
-// Данные траффика по соединениям
-type NetTopByConnection struct {
+// Сведения о дисках (usage)
+type DiskUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol        string    `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
-	Bytes           uint64    `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes"`
-	Percent         float64   `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent"`
-	SourceAddr      *SockAddr `protobuf:"bytes,4,opt,name=sourceAddr,proto3" json:"sourceAddr"`
-	DestinationAddr *SockAddr `protobuf:"bytes,5,opt,name=destinationAddr,proto3" json:"destinationAddr"`
+	Device                string  `protobuf:"bytes,1,opt,name=device,proto3" json:"device"`
+	MountPoint            string  `protobuf:"bytes,2,opt,name=mountPoint,proto3" json:"mountPoint"`
+	UsagePercent          float64 `protobuf:"fixed64,3,opt,name=usagePercent,proto3" json:"usagePercent"`
+	Usage                 float64 `protobuf:"fixed64,4,opt,name=usage,proto3" json:"usage"`
+	InodeCount            float64 `protobuf:"fixed64,5,opt,name=inodeCount,proto3" json:"inodeCount"`
+	InodeAvailablePercent float64 `protobuf:"fixed64,6,opt,name=inodeAvailablePercent,proto3" json:"inodeAvailablePercent"`
+	FsType                string  `protobuf:"bytes,7,opt,name=fsType,proto3" json:"fsType"`
+	ReadOnly              bool    `protobuf:"varint,8,opt,name=readOnly,proto3" json:"readOnly"`
+	Total                 uint64  `protobuf:"varint,9,opt,name=total,proto3" json:"total"`
+	Used                  uint64  `protobuf:"varint,10,opt,name=used,proto3" json:"used"`
+	Free                  uint64  `protobuf:"varint,11,opt,name=free,proto3" json:"free"`
+	InodesUsed            uint64  `protobuf:"varint,12,opt,name=inodesUsed,proto3" json:"inodesUsed"`
+	InodesFree            uint64  `protobuf:"varint,13,opt,name=inodesFree,proto3" json:"inodesFree"`
+	// Точка монтирования не отвечает, значения взяты из последнего успешного опроса
+	Stale     bool            `protobuf:"varint,14,opt,name=stale,proto3" json:"stale"`
+	Forecasts []*DiskForecast `protobuf:"bytes,15,rep,name=forecasts,proto3" json:"forecasts"`
+	// Минимальная оценка времени до заполнения по всем окнам, 0 - заполнение не прогнозируется
+	TimeToFullSec float64 `protobuf:"fixed64,16,opt,name=timeToFullSec,proto3" json:"timeToFullSec"`
+	// Прогнозируемое заполнение наступит раньше порога из настроек демона
+	FillingSoon bool `protobuf:"varint,17,opt,name=fillingSoon,proto3" json:"fillingSoon"`
 }
 
-func (x *NetTopByConnection) Reset() {
-	*x = NetTopByConnection{}
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simda_proto_msgTypes[10]
+		mi := &file_simda_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetTopByConnection) String() string {
+func (x *DiskUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetTopByConnection) ProtoMessage() {}
+func (*DiskUsage) ProtoMessage() {}
 
-func (x *NetTopByConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_simda_proto_msgTypes[10]
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,80 +878,159 @@ func (x *NetTopByConnection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetTopByConnection.ProtoReflect.Descriptor instead.
-func (*NetTopByConnection) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NetTopByConnection) GetProtocol() string {
+func (x *DiskUsage) GetDevice() string {
 	if x != nil {
-		return x.Protocol
+		return x.Device
 	}
 	return ""
 }
 
-func (x *NetTopByConnection) GetBytes() uint64 {
+func (x *DiskUsage) GetMountPoint() string {
 	if x != nil {
-		return x.Bytes
+		return x.MountPoint
 	}
-	return 0
+	return ""
 }
 
-func (x *NetTopByConnection) GetPercent() float64 {
+func (x *DiskUsage) GetUsagePercent() float64 {
 	if x != nil {
-		return x.Percent
+		return x.UsagePercent
 	}
 	return 0
 }
 
-func (x *NetTopByConnection) GetSourceAddr() *SockAddr {
+func (x *DiskUsage) GetUsage() float64 {
 	if x != nil {
-		return x.SourceAddr
+		return x.Usage
 	}
-	return nil
+	return 0
 }
 
-func (x *NetTopByConnection) GetDestinationAddr() *SockAddr {
+func (x *DiskUsage) GetInodeCount() float64 {
 	if x != nil {
-		return x.DestinationAddr
+		return x.InodeCount
 	}
-	return nil
+	return 0
 }
 
-// Сообщение, содержащее сведения о включенных/отключенных в настройках метриках.
-// Служит для корректного отображения в клиенте состояния той или иной метрики
-type EnabledMetrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LoadAvg             bool `protobuf:"varint,1,opt,name=loadAvg,proto3" json:"loadAvg"`
-	CpuAvg              bool `protobuf:"varint,2,opt,name=cpuAvg,proto3" json:"cpuAvg"`
-	DiskIO              bool `protobuf:"varint,3,opt,name=diskIO,proto3" json:"diskIO"`
-	DiskUsage           bool `protobuf:"varint,4,opt,name=diskUsage,proto3" json:"diskUsage"`
-	NetConnections      bool `protobuf:"varint,5,opt,name=netConnections,proto3" json:"netConnections"`
-	NetConnectionStates bool `protobuf:"varint,6,opt,name=netConnectionStates,proto3" json:"netConnectionStates"`
-	NetTopByProtocol    bool `protobuf:"varint,7,opt,name=netTopByProtocol,proto3" json:"netTopByProtocol"`
-	NetTopByConnection  bool `protobuf:"varint,8,opt,name=netTopByConnection,proto3" json:"netTopByConnection"`
+func (x *DiskUsage) GetInodeAvailablePercent() float64 {
+	if x != nil {
+		return x.InodeAvailablePercent
+	}
+	return 0
 }
 
-func (x *EnabledMetrics) Reset() {
-	*x = EnabledMetrics{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_simda_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DiskUsage) GetFsType() string {
+	if x != nil {
+		return x.FsType
 	}
+	return ""
 }
 
-func (x *EnabledMetrics) String() string {
+func (x *DiskUsage) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *DiskUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesUsed() uint64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesFree() uint64 {
+	if x != nil {
+		return x.InodesFree
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *DiskUsage) GetForecasts() []*DiskForecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
+func (x *DiskUsage) GetTimeToFullSec() float64 {
+	if x != nil {
+		return x.TimeToFullSec
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetFillingSoon() bool {
+	if x != nil {
+		return x.FillingSoon
+	}
+	return false
+}
+
+// Прогноз заполнения файловой системы по одному окну
+type DiskForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window        string  `protobuf:"bytes,1,opt,name=window,proto3" json:"window"`
+	BytesPerSec   float64 `protobuf:"fixed64,2,opt,name=bytesPerSec,proto3" json:"bytesPerSec"`
+	InodesPerSec  float64 `protobuf:"fixed64,3,opt,name=inodesPerSec,proto3" json:"inodesPerSec"`
+	TimeToFullSec float64 `protobuf:"fixed64,4,opt,name=timeToFullSec,proto3" json:"timeToFullSec"`
+}
+
+func (x *DiskForecast) Reset() {
+	*x = DiskForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskForecast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnabledMetrics) ProtoMessage() {}
+func (*DiskForecast) ProtoMessage() {}
 
-func (x *EnabledMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_simda_proto_msgTypes[11]
+func (x *DiskForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,86 +1041,169 @@ func (x *EnabledMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnabledMetrics.ProtoReflect.Descriptor instead.
-func (*EnabledMetrics) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DiskForecast.ProtoReflect.Descriptor instead.
+func (*DiskForecast) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EnabledMetrics) GetLoadAvg() bool {
+func (x *DiskForecast) GetWindow() string {
 	if x != nil {
-		return x.LoadAvg
+		return x.Window
 	}
-	return false
+	return ""
 }
 
-func (x *EnabledMetrics) GetCpuAvg() bool {
+func (x *DiskForecast) GetBytesPerSec() float64 {
 	if x != nil {
-		return x.CpuAvg
+		return x.BytesPerSec
 	}
-	return false
+	return 0
 }
 
-func (x *EnabledMetrics) GetDiskIO() bool {
+func (x *DiskForecast) GetInodesPerSec() float64 {
 	if x != nil {
-		return x.DiskIO
+		return x.InodesPerSec
 	}
-	return false
+	return 0
 }
 
-func (x *EnabledMetrics) GetDiskUsage() bool {
+func (x *DiskForecast) GetTimeToFullSec() float64 {
 	if x != nil {
-		return x.DiskUsage
+		return x.TimeToFullSec
 	}
-	return false
+	return 0
 }
 
-func (x *EnabledMetrics) GetNetConnections() bool {
+// Сведения о процессе
+type Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid     uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid"`
+	CmdLine string `protobuf:"bytes,2,opt,name=cmdLine,proto3" json:"cmdLine"`
+}
+
+func (x *Process) Reset() {
+	*x = Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Process) ProtoMessage() {}
+
+func (x *Process) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Process.ProtoReflect.Descriptor instead.
+func (*Process) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Process) GetPid() uint32 {
 	if x != nil {
-		return x.NetConnections
+		return x.Pid
 	}
-	return false
+	return 0
 }
 
-func (x *EnabledMetrics) GetNetConnectionStates() bool {
+func (x *Process) GetCmdLine() string {
 	if x != nil {
-		return x.NetConnectionStates
+		return x.CmdLine
 	}
-	return false
+	return ""
 }
 
-func (x *EnabledMetrics) GetNetTopByProtocol() bool {
+// Сетевой адрес
+type SockAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip   string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port"`
+}
+
+func (x *SockAddr) Reset() {
+	*x = SockAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SockAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SockAddr) ProtoMessage() {}
+
+func (x *SockAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SockAddr.ProtoReflect.Descriptor instead.
+func (*SockAddr) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SockAddr) GetIp() string {
 	if x != nil {
-		return x.NetTopByProtocol
+		return x.Ip
 	}
-	return false
+	return ""
 }
 
-func (x *EnabledMetrics) GetNetTopByConnection() bool {
+func (x *SockAddr) GetPort() uint32 {
 	if x != nil {
-		return x.NetTopByConnection
+		return x.Port
 	}
-	return false
+	return 0
 }
 
-// Снимок метрик
-type Snapshot struct {
+// Сетевые соединения
+type NetConnection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metrics              *EnabledMetrics        `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics"`
-	LoadAvg              *LoadAverage           `protobuf:"bytes,2,opt,name=loadAvg,proto3" json:"loadAvg"`
-	CpuAvg               *CpuAverage            `protobuf:"bytes,3,opt,name=cpuAvg,proto3" json:"cpuAvg"`
-	DiskUsage            []*DiskUsage           `protobuf:"bytes,4,rep,name=diskUsage,proto3" json:"diskUsage"`
-	DiskIO               []*DiskIO              `protobuf:"bytes,5,rep,name=diskIO,proto3" json:"diskIO"`
-	NetConnections       []*NetConnection       `protobuf:"bytes,6,rep,name=netConnections,proto3" json:"netConnections"`
-	NetConnectionsStates []*NetConnectionStates `protobuf:"bytes,7,rep,name=netConnectionsStates,proto3" json:"netConnectionsStates"`
-	NetTopByProtocol     []*NetTopByProtocol    `protobuf:"bytes,8,rep,name=netTopByProtocol,proto3" json:"netTopByProtocol"`
-	NetTopByConnection   []*NetTopByConnection  `protobuf:"bytes,9,rep,name=netTopByConnection,proto3" json:"netTopByConnection"`
+	Protocol    string    `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	Process     *Process  `protobuf:"bytes,2,opt,name=process,proto3,oneof" json:"process"`
+	User        string    `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	LocalAddr   *SockAddr `protobuf:"bytes,4,opt,name=localAddr,proto3,oneof" json:"localAddr"`
+	ForeignAddr *SockAddr `protobuf:"bytes,5,opt,name=foreignAddr,proto3,oneof" json:"foreignAddr"`
+	State       string    `protobuf:"bytes,6,opt,name=state,proto3" json:"state"`
+	UserId      uint32    `protobuf:"varint,7,opt,name=userId,proto3" json:"userId"`
+	SocketId    string    `protobuf:"bytes,8,opt,name=socketId,proto3" json:"socketId"`
 }
 
-func (x *Snapshot) Reset() {
-	*x = Snapshot{}
+func (x *NetConnection) Reset() {
+	*x = NetConnection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_simda_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,312 +1211,4159 @@ func (x *Snapshot) Reset() {
 	}
 }
 
-func (x *Snapshot) String() string {
+func (x *NetConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Snapshot) ProtoMessage() {}
+func (*NetConnection) ProtoMessage() {}
 
-func (x *Snapshot) ProtoReflect() protoreflect.Message {
+func (x *NetConnection) ProtoReflect() protoreflect.Message {
 	mi := &file_simda_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
-func (*Snapshot) Descriptor() ([]byte, []int) {
-	return file_simda_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Snapshot) GetMetrics() *EnabledMetrics {
-	if x != nil {
-		return x.Metrics
-	}
-	return nil
-}
-
-func (x *Snapshot) GetLoadAvg() *LoadAverage {
-	if x != nil {
-		return x.LoadAvg
-	}
-	return nil
-}
-
-func (x *Snapshot) GetCpuAvg() *CpuAverage {
-	if x != nil {
-		return x.CpuAvg
-	}
-	return nil
-}
-
-func (x *Snapshot) GetDiskUsage() []*DiskUsage {
-	if x != nil {
-		return x.DiskUsage
-	}
-	return nil
-}
-
-func (x *Snapshot) GetDiskIO() []*DiskIO {
-	if x != nil {
-		return x.DiskIO
-	}
-	return nil
-}
-
-func (x *Snapshot) GetNetConnections() []*NetConnection {
-	if x != nil {
-		return x.NetConnections
-	}
-	return nil
-}
-
-func (x *Snapshot) GetNetConnectionsStates() []*NetConnectionStates {
-	if x != nil {
-		return x.NetConnectionsStates
-	}
-	return nil
-}
-
-func (x *Snapshot) GetNetTopByProtocol() []*NetTopByProtocol {
-	if x != nil {
-		return x.NetTopByProtocol
-	}
-	return nil
-}
-
-func (x *Snapshot) GetNetTopByConnection() []*NetTopByConnection {
-	if x != nil {
-		return x.NetTopByConnection
-	}
-	return nil
-}
-
-var File_simda_proto protoreflect.FileDescriptor
-
-var file_simda_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x64, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xf1, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67,
-	0x3a, 0xb3, 0x01, 0xba, 0x48, 0xaf, 0x01, 0x1a, 0x5a, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x57, 0x61, 0x72, 0x6d,
-	0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61,
-	0x74, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x1a, 0x1b, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x77, 0x61, 0x72,
-	0x6d, 0x69, 0x6e, 0x67, 0x20, 0x3e, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x1a, 0x51, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x77,
-	0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x57, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x20,
-	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x72, 0x20,
-	0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x31, 0x32, 0x30, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x1a, 0x13, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x20,
-	0x3c, 0x3d, 0x20, 0x31, 0x32, 0x30, 0x22, 0x4d, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66,
-	0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x69,
-	0x66, 0x74, 0x65, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x0a, 0x43, 0x70, 0x75, 0x41, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
-	0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69,
-	0x64, 0x6c, 0x65, 0x22, 0x62, 0x0a, 0x06, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x74, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
-	0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0c, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x6f, 0x64, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x6f,
-	0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x6f, 0x64, 0x65,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a,
-	0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d,
-	0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64,
-	0x4c, 0x69, 0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x08, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x22, 0xb5, 0x02, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x48, 0x01, 0x52, 0x09, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64,
-	0x64, 0x72, 0x48, 0x02, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0x41, 0x0a, 0x13,
-	0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x5e, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
-	0xce, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x3a, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52,
-	0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0xae, 0x02, 0x0a, 0x0e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
-	0x70, 0x75, 0x41, 0x76, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x12, 0x1c, 0x0a,
-	0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6e,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x13, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42,
-	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6e,
-	0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x92, 0x04, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30,
-	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x12, 0x2d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41,
-	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12,
-	0x2a, 0x0a, 0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x70, 0x75, 0x41, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x52, 0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67, 0x12, 0x2f, 0x0a, 0x09, 0x64,
-	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x06,
-	0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x52, 0x06, 0x64, 0x69,
-	0x73, 0x6b, 0x49, 0x4f, 0x12, 0x3d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x14,
-	0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x4a, 0x0a, 0x12, 0x6e, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x41, 0x0a, 0x05, 0x53, 0x69, 0x6d, 0x64, 0x61, 0x12,
-	0x38, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x12, 0x0f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_simda_proto_rawDescOnce sync.Once
-	file_simda_proto_rawDescData = file_simda_proto_rawDesc
-)
-
-func file_simda_proto_rawDescGZIP() []byte {
-	file_simda_proto_rawDescOnce.Do(func() {
-		file_simda_proto_rawDescData = protoimpl.X.CompressGZIP(file_simda_proto_rawDescData)
-	})
-	return file_simda_proto_rawDescData
-}
-
-var file_simda_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_simda_proto_goTypes = []interface{}{
-	(*Request)(nil),             // 0: daemon.Request
-	(*LoadAverage)(nil),         // 1: daemon.LoadAverage
-	(*CpuAverage)(nil),          // 2: daemon.CpuAverage
-	(*DiskIO)(nil),              // 3: daemon.DiskIO
-	(*DiskUsage)(nil),           // 4: daemon.DiskUsage
-	(*Process)(nil),             // 5: daemon.Process
-	(*SockAddr)(nil),            // 6: daemon.SockAddr
-	(*NetConnection)(nil),       // 7: daemon.NetConnection
-	(*NetConnectionStates)(nil), // 8: daemon.NetConnectionStates
-	(*NetTopByProtocol)(nil),    // 9: daemon.NetTopByProtocol
-	(*NetTopByConnection)(nil),  // 10: daemon.NetTopByConnection
-	(*EnabledMetrics)(nil),      // 11: daemon.EnabledMetrics
-	(*Snapshot)(nil),            // 12: daemon.Snapshot
-}
-var file_simda_proto_depIdxs = []int32{
-	5,  // 0: daemon.NetConnection.process:type_name -> daemon.Process
-	6,  // 1: daemon.NetConnection.localAddr:type_name -> daemon.SockAddr
-	6,  // 2: daemon.NetConnection.foreignAddr:type_name -> daemon.SockAddr
-	6,  // 3: daemon.NetTopByConnection.sourceAddr:type_name -> daemon.SockAddr
-	6,  // 4: daemon.NetTopByConnection.destinationAddr:type_name -> daemon.SockAddr
-	11, // 5: daemon.Snapshot.metrics:type_name -> daemon.EnabledMetrics
-	1,  // 6: daemon.Snapshot.loadAvg:type_name -> daemon.LoadAverage
-	2,  // 7: daemon.Snapshot.cpuAvg:type_name -> daemon.CpuAverage
-	4,  // 8: daemon.Snapshot.diskUsage:type_name -> daemon.DiskUsage
-	3,  // 9: daemon.Snapshot.diskIO:type_name -> daemon.DiskIO
-	7,  // 10: daemon.Snapshot.netConnections:type_name -> daemon.NetConnection
-	8,  // 11: daemon.Snapshot.netConnectionsStates:type_name -> daemon.NetConnectionStates
-	9,  // 12: daemon.Snapshot.netTopByProtocol:type_name -> daemon.NetTopByProtocol
-	10, // 13: daemon.Snapshot.netTopByConnection:type_name -> daemon.NetTopByConnection
-	0,  // 14: daemon.Simda.StreamSnapshots:input_type -> daemon.Request
-	12, // 15: daemon.Simda.StreamSnapshots:output_type -> daemon.Snapshot
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
-}
-
-func init() { file_simda_proto_init() }
-func file_simda_proto_init() {
-	if File_simda_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_simda_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetConnection.ProtoReflect.Descriptor instead.
+func (*NetConnection) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NetConnection) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetConnection) GetProcess() *Process {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *NetConnection) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *NetConnection) GetLocalAddr() *SockAddr {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return nil
+}
+
+func (x *NetConnection) GetForeignAddr() *SockAddr {
+	if x != nil {
+		return x.ForeignAddr
+	}
+	return nil
+}
+
+func (x *NetConnection) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NetConnection) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NetConnection) GetSocketId() string {
+	if x != nil {
+		return x.SocketId
+	}
+	return ""
+}
+
+// Сетевые соединения по состояниям
+type NetConnectionStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+}
+
+func (x *NetConnectionStates) Reset() {
+	*x = NetConnectionStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetConnectionStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetConnectionStates) ProtoMessage() {}
+
+func (x *NetConnectionStates) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetConnectionStates.ProtoReflect.Descriptor instead.
+func (*NetConnectionStates) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NetConnectionStates) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NetConnectionStates) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Данные траффика по протоколам
+type NetTopByProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	Bytes    uint64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes"`
+	Percent  float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent"`
+	// Статистика трафика протокола в байтах в секунду
+	BytesPerSecStats *Aggregates     `protobuf:"bytes,4,opt,name=bytesPerSecStats,proto3" json:"bytesPerSecStats"`
+	Anomalies        []*AnomalyScore `protobuf:"bytes,5,rep,name=anomalies,proto3" json:"anomalies"`
+}
+
+func (x *NetTopByProtocol) Reset() {
+	*x = NetTopByProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetTopByProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetTopByProtocol) ProtoMessage() {}
+
+func (x *NetTopByProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetTopByProtocol.ProtoReflect.Descriptor instead.
+func (*NetTopByProtocol) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NetTopByProtocol) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetTopByProtocol) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetTopByProtocol) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *NetTopByProtocol) GetBytesPerSecStats() *Aggregates {
+	if x != nil {
+		return x.BytesPerSecStats
+	}
+	return nil
+}
+
+func (x *NetTopByProtocol) GetAnomalies() []*AnomalyScore {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+// Данные траффика по прикладным протоколам
+type NetTopByApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol    string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	Application string  `protobuf:"bytes,2,opt,name=application,proto3" json:"application"`
+	Bytes       uint64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes"`
+	Percent     float64 `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent"`
+}
+
+func (x *NetTopByApplication) Reset() {
+	*x = NetTopByApplication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetTopByApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetTopByApplication) ProtoMessage() {}
+
+func (x *NetTopByApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetTopByApplication.ProtoReflect.Descriptor instead.
+func (*NetTopByApplication) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NetTopByApplication) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetTopByApplication) GetApplication() string {
+	if x != nil {
+		return x.Application
+	}
+	return ""
+}
+
+func (x *NetTopByApplication) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetTopByApplication) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// Данные траффика по соединениям
+type NetTopByConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol        string    `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	Bytes           uint64    `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes"`
+	Percent         float64   `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent"`
+	SourceAddr      *SockAddr `protobuf:"bytes,4,opt,name=sourceAddr,proto3" json:"sourceAddr"`
+	DestinationAddr *SockAddr `protobuf:"bytes,5,opt,name=destinationAddr,proto3" json:"destinationAddr"`
+}
+
+func (x *NetTopByConnection) Reset() {
+	*x = NetTopByConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetTopByConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetTopByConnection) ProtoMessage() {}
+
+func (x *NetTopByConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetTopByConnection.ProtoReflect.Descriptor instead.
+func (*NetTopByConnection) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NetTopByConnection) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetTopByConnection) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetTopByConnection) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *NetTopByConnection) GetSourceAddr() *SockAddr {
+	if x != nil {
+		return x.SourceAddr
+	}
+	return nil
+}
+
+func (x *NetTopByConnection) GetDestinationAddr() *SockAddr {
+	if x != nil {
+		return x.DestinationAddr
+	}
+	return nil
+}
+
+// Данные траффика по процессам
+type NetTopByProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid                uint32      `protobuf:"varint,1,opt,name=pid,proto3" json:"pid"`
+	Command            string      `protobuf:"bytes,2,opt,name=command,proto3" json:"command"`
+	User               string      `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	RxBytesPerSec      float64     `protobuf:"fixed64,4,opt,name=rxBytesPerSec,proto3" json:"rxBytesPerSec"`
+	TxBytesPerSec      float64     `protobuf:"fixed64,5,opt,name=txBytesPerSec,proto3" json:"txBytesPerSec"`
+	RxBytesPerSecStats *Aggregates `protobuf:"bytes,6,opt,name=rxBytesPerSecStats,proto3" json:"rxBytesPerSecStats"`
+	TxBytesPerSecStats *Aggregates `protobuf:"bytes,7,opt,name=txBytesPerSecStats,proto3" json:"txBytesPerSecStats"`
+}
+
+func (x *NetTopByProcess) Reset() {
+	*x = NetTopByProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetTopByProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetTopByProcess) ProtoMessage() {}
+
+func (x *NetTopByProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetTopByProcess.ProtoReflect.Descriptor instead.
+func (*NetTopByProcess) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NetTopByProcess) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *NetTopByProcess) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *NetTopByProcess) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *NetTopByProcess) GetRxBytesPerSec() float64 {
+	if x != nil {
+		return x.RxBytesPerSec
+	}
+	return 0
+}
+
+func (x *NetTopByProcess) GetTxBytesPerSec() float64 {
+	if x != nil {
+		return x.TxBytesPerSec
+	}
+	return 0
+}
+
+func (x *NetTopByProcess) GetRxBytesPerSecStats() *Aggregates {
+	if x != nil {
+		return x.RxBytesPerSecStats
+	}
+	return nil
+}
+
+func (x *NetTopByProcess) GetTxBytesPerSecStats() *Aggregates {
+	if x != nil {
+		return x.TxBytesPerSecStats
+	}
+	return nil
+}
+
+// Статистика DNS
+type DnsCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+}
+
+func (x *DnsCounter) Reset() {
+	*x = DnsCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsCounter) ProtoMessage() {}
+
+func (x *DnsCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsCounter.ProtoReflect.Descriptor instead.
+func (*DnsCounter) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DnsCounter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DnsCounter) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type DnsResolver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip           string  `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	Queries      uint64  `protobuf:"varint,2,opt,name=queries,proto3" json:"queries"`
+	Responses    uint64  `protobuf:"varint,3,opt,name=responses,proto3" json:"responses"`
+	AvgLatencyMs float64 `protobuf:"fixed64,4,opt,name=avgLatencyMs,proto3" json:"avgLatencyMs"`
+	MaxLatencyMs float64 `protobuf:"fixed64,5,opt,name=maxLatencyMs,proto3" json:"maxLatencyMs"`
+}
+
+func (x *DnsResolver) Reset() {
+	*x = DnsResolver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsResolver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsResolver) ProtoMessage() {}
+
+func (x *DnsResolver) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsResolver.ProtoReflect.Descriptor instead.
+func (*DnsResolver) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DnsResolver) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *DnsResolver) GetQueries() uint64 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *DnsResolver) GetResponses() uint64 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *DnsResolver) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *DnsResolver) GetMaxLatencyMs() float64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+type DnsStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries         uint64         `protobuf:"varint,1,opt,name=queries,proto3" json:"queries"`
+	Responses       uint64         `protobuf:"varint,2,opt,name=responses,proto3" json:"responses"`
+	NxdomainPercent float64        `protobuf:"fixed64,3,opt,name=nxdomainPercent,proto3" json:"nxdomainPercent"`
+	ServfailPercent float64        `protobuf:"fixed64,4,opt,name=servfailPercent,proto3" json:"servfailPercent"`
+	TopDomains      []*DnsCounter  `protobuf:"bytes,5,rep,name=topDomains,proto3" json:"topDomains"`
+	QueryTypes      []*DnsCounter  `protobuf:"bytes,6,rep,name=queryTypes,proto3" json:"queryTypes"`
+	ResponseCodes   []*DnsCounter  `protobuf:"bytes,7,rep,name=responseCodes,proto3" json:"responseCodes"`
+	Resolvers       []*DnsResolver `protobuf:"bytes,8,rep,name=resolvers,proto3" json:"resolvers"`
+}
+
+func (x *DnsStat) Reset() {
+	*x = DnsStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsStat) ProtoMessage() {}
+
+func (x *DnsStat) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsStat.ProtoReflect.Descriptor instead.
+func (*DnsStat) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DnsStat) GetQueries() uint64 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *DnsStat) GetResponses() uint64 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *DnsStat) GetNxdomainPercent() float64 {
+	if x != nil {
+		return x.NxdomainPercent
+	}
+	return 0
+}
+
+func (x *DnsStat) GetServfailPercent() float64 {
+	if x != nil {
+		return x.ServfailPercent
+	}
+	return 0
+}
+
+func (x *DnsStat) GetTopDomains() []*DnsCounter {
+	if x != nil {
+		return x.TopDomains
+	}
+	return nil
+}
+
+func (x *DnsStat) GetQueryTypes() []*DnsCounter {
+	if x != nil {
+		return x.QueryTypes
+	}
+	return nil
+}
+
+func (x *DnsStat) GetResponseCodes() []*DnsCounter {
+	if x != nil {
+		return x.ResponseCodes
+	}
+	return nil
+}
+
+func (x *DnsStat) GetResolvers() []*DnsResolver {
+	if x != nil {
+		return x.Resolvers
+	}
+	return nil
+}
+
+// Показания аппаратного датчика. Температура в градусах Цельсия, вентиляторы в об/мин,
+// напряжение в вольтах. Нулевые max и critical означают, что порог не задан
+type Sensor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chip     string  `protobuf:"bytes,1,opt,name=chip,proto3" json:"chip"`
+	Label    string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label"`
+	Kind     string  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind"`
+	Value    float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value"`
+	Max      float64 `protobuf:"fixed64,5,opt,name=max,proto3" json:"max"`
+	Critical float64 `protobuf:"fixed64,6,opt,name=critical,proto3" json:"critical"`
+}
+
+func (x *Sensor) Reset() {
+	*x = Sensor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sensor) ProtoMessage() {}
+
+func (x *Sensor) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sensor.ProtoReflect.Descriptor instead.
+func (*Sensor) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Sensor) GetChip() string {
+	if x != nil {
+		return x.Chip
+	}
+	return ""
+}
+
+func (x *Sensor) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Sensor) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Sensor) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Sensor) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *Sensor) GetCritical() float64 {
+	if x != nil {
+		return x.Critical
+	}
+	return 0
+}
+
+// Счетчики сетевого стека ядра. Ошибки и сбросы - скорость в секунду, UDP и ICMP с учетом IPv6.
+// Сведения о сокетах - текущие значения из sockstat, память в байтах
+type NetStack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TcpRetransSegs  float64 `protobuf:"fixed64,1,opt,name=tcpRetransSegs,proto3" json:"tcpRetransSegs"`
+	TcpOutRsts      float64 `protobuf:"fixed64,2,opt,name=tcpOutRsts,proto3" json:"tcpOutRsts"`
+	TcpEstabResets  float64 `protobuf:"fixed64,3,opt,name=tcpEstabResets,proto3" json:"tcpEstabResets"`
+	TcpAttemptFails float64 `protobuf:"fixed64,4,opt,name=tcpAttemptFails,proto3" json:"tcpAttemptFails"`
+	TcpInErrs       float64 `protobuf:"fixed64,5,opt,name=tcpInErrs,proto3" json:"tcpInErrs"`
+	ListenOverflows float64 `protobuf:"fixed64,6,opt,name=listenOverflows,proto3" json:"listenOverflows"`
+	ListenDrops     float64 `protobuf:"fixed64,7,opt,name=listenDrops,proto3" json:"listenDrops"`
+	UdpRcvbufErrors float64 `protobuf:"fixed64,8,opt,name=udpRcvbufErrors,proto3" json:"udpRcvbufErrors"`
+	UdpSndbufErrors float64 `protobuf:"fixed64,9,opt,name=udpSndbufErrors,proto3" json:"udpSndbufErrors"`
+	UdpInErrors     float64 `protobuf:"fixed64,10,opt,name=udpInErrors,proto3" json:"udpInErrors"`
+	IcmpInErrors    float64 `protobuf:"fixed64,11,opt,name=icmpInErrors,proto3" json:"icmpInErrors"`
+	IcmpOutErrors   float64 `protobuf:"fixed64,12,opt,name=icmpOutErrors,proto3" json:"icmpOutErrors"`
+	SocketsUsed     uint64  `protobuf:"varint,13,opt,name=socketsUsed,proto3" json:"socketsUsed"`
+	TcpInUse        uint64  `protobuf:"varint,14,opt,name=tcpInUse,proto3" json:"tcpInUse"`
+	TcpOrphan       uint64  `protobuf:"varint,15,opt,name=tcpOrphan,proto3" json:"tcpOrphan"`
+	TcpTimeWait     uint64  `protobuf:"varint,16,opt,name=tcpTimeWait,proto3" json:"tcpTimeWait"`
+	TcpAlloc        uint64  `protobuf:"varint,17,opt,name=tcpAlloc,proto3" json:"tcpAlloc"`
+	TcpMemBytes     uint64  `protobuf:"varint,18,opt,name=tcpMemBytes,proto3" json:"tcpMemBytes"`
+	UdpInUse        uint64  `protobuf:"varint,19,opt,name=udpInUse,proto3" json:"udpInUse"`
+	UdpMemBytes     uint64  `protobuf:"varint,20,opt,name=udpMemBytes,proto3" json:"udpMemBytes"`
+	Tcp6InUse       uint64  `protobuf:"varint,21,opt,name=tcp6InUse,proto3" json:"tcp6InUse"`
+	Udp6InUse       uint64  `protobuf:"varint,22,opt,name=udp6InUse,proto3" json:"udp6InUse"`
+}
+
+func (x *NetStack) Reset() {
+	*x = NetStack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetStack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetStack) ProtoMessage() {}
+
+func (x *NetStack) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetStack.ProtoReflect.Descriptor instead.
+func (*NetStack) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NetStack) GetTcpRetransSegs() float64 {
+	if x != nil {
+		return x.TcpRetransSegs
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpOutRsts() float64 {
+	if x != nil {
+		return x.TcpOutRsts
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpEstabResets() float64 {
+	if x != nil {
+		return x.TcpEstabResets
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpAttemptFails() float64 {
+	if x != nil {
+		return x.TcpAttemptFails
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpInErrs() float64 {
+	if x != nil {
+		return x.TcpInErrs
+	}
+	return 0
+}
+
+func (x *NetStack) GetListenOverflows() float64 {
+	if x != nil {
+		return x.ListenOverflows
+	}
+	return 0
+}
+
+func (x *NetStack) GetListenDrops() float64 {
+	if x != nil {
+		return x.ListenDrops
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdpRcvbufErrors() float64 {
+	if x != nil {
+		return x.UdpRcvbufErrors
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdpSndbufErrors() float64 {
+	if x != nil {
+		return x.UdpSndbufErrors
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdpInErrors() float64 {
+	if x != nil {
+		return x.UdpInErrors
+	}
+	return 0
+}
+
+func (x *NetStack) GetIcmpInErrors() float64 {
+	if x != nil {
+		return x.IcmpInErrors
+	}
+	return 0
+}
+
+func (x *NetStack) GetIcmpOutErrors() float64 {
+	if x != nil {
+		return x.IcmpOutErrors
+	}
+	return 0
+}
+
+func (x *NetStack) GetSocketsUsed() uint64 {
+	if x != nil {
+		return x.SocketsUsed
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpInUse() uint64 {
+	if x != nil {
+		return x.TcpInUse
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpOrphan() uint64 {
+	if x != nil {
+		return x.TcpOrphan
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpTimeWait() uint64 {
+	if x != nil {
+		return x.TcpTimeWait
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpAlloc() uint64 {
+	if x != nil {
+		return x.TcpAlloc
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcpMemBytes() uint64 {
+	if x != nil {
+		return x.TcpMemBytes
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdpInUse() uint64 {
+	if x != nil {
+		return x.UdpInUse
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdpMemBytes() uint64 {
+	if x != nil {
+		return x.UdpMemBytes
+	}
+	return 0
+}
+
+func (x *NetStack) GetTcp6InUse() uint64 {
+	if x != nil {
+		return x.Tcp6InUse
+	}
+	return 0
+}
+
+func (x *NetStack) GetUdp6InUse() uint64 {
+	if x != nil {
+		return x.Udp6InUse
+	}
+	return 0
+}
+
+// Скорость обработки прерывания в секунду по процессорам. topCpu - процессор с наибольшей долей
+// прерывания, imbalanced - дисбаланс обнаружен у устройства, которому принадлежит прерывание
+type Interrupt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Irq           string    `protobuf:"bytes,1,opt,name=irq,proto3" json:"irq"`
+	Device        string    `protobuf:"bytes,2,opt,name=device,proto3" json:"device"`
+	PerCpu        []float64 `protobuf:"fixed64,3,rep,packed,name=perCpu,proto3" json:"perCpu"`
+	Total         float64   `protobuf:"fixed64,4,opt,name=total,proto3" json:"total"`
+	TopCpu        uint32    `protobuf:"varint,5,opt,name=topCpu,proto3" json:"topCpu"`
+	TopCpuPercent float64   `protobuf:"fixed64,6,opt,name=topCpuPercent,proto3" json:"topCpuPercent"`
+	Imbalanced    bool      `protobuf:"varint,7,opt,name=imbalanced,proto3" json:"imbalanced"`
+}
+
+func (x *Interrupt) Reset() {
+	*x = Interrupt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interrupt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interrupt) ProtoMessage() {}
+
+func (x *Interrupt) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interrupt.ProtoReflect.Descriptor instead.
+func (*Interrupt) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Interrupt) GetIrq() string {
+	if x != nil {
+		return x.Irq
+	}
+	return ""
+}
+
+func (x *Interrupt) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Interrupt) GetPerCpu() []float64 {
+	if x != nil {
+		return x.PerCpu
+	}
+	return nil
+}
+
+func (x *Interrupt) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Interrupt) GetTopCpu() uint32 {
+	if x != nil {
+		return x.TopCpu
+	}
+	return 0
+}
+
+func (x *Interrupt) GetTopCpuPercent() float64 {
+	if x != nil {
+		return x.TopCpuPercent
+	}
+	return 0
+}
+
+func (x *Interrupt) GetImbalanced() bool {
+	if x != nil {
+		return x.Imbalanced
+	}
+	return false
+}
+
+// Прерывания устройства, просуммированные по всем его линиям, например, по очередям сетевой карты.
+// topCpu - процессор с наибольшей долей, imbalanced - его доля превышает порог из настроек демона
+type InterruptDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device        string    `protobuf:"bytes,1,opt,name=device,proto3" json:"device"`
+	Irqs          []string  `protobuf:"bytes,2,rep,name=irqs,proto3" json:"irqs"`
+	PerCpu        []float64 `protobuf:"fixed64,3,rep,packed,name=perCpu,proto3" json:"perCpu"`
+	Total         float64   `protobuf:"fixed64,4,opt,name=total,proto3" json:"total"`
+	TopCpu        uint32    `protobuf:"varint,5,opt,name=topCpu,proto3" json:"topCpu"`
+	TopCpuPercent float64   `protobuf:"fixed64,6,opt,name=topCpuPercent,proto3" json:"topCpuPercent"`
+	Imbalanced    bool      `protobuf:"varint,7,opt,name=imbalanced,proto3" json:"imbalanced"`
+}
+
+func (x *InterruptDevice) Reset() {
+	*x = InterruptDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterruptDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterruptDevice) ProtoMessage() {}
+
+func (x *InterruptDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterruptDevice.ProtoReflect.Descriptor instead.
+func (*InterruptDevice) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InterruptDevice) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *InterruptDevice) GetIrqs() []string {
+	if x != nil {
+		return x.Irqs
+	}
+	return nil
+}
+
+func (x *InterruptDevice) GetPerCpu() []float64 {
+	if x != nil {
+		return x.PerCpu
+	}
+	return nil
+}
+
+func (x *InterruptDevice) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InterruptDevice) GetTopCpu() uint32 {
+	if x != nil {
+		return x.TopCpu
+	}
+	return 0
+}
+
+func (x *InterruptDevice) GetTopCpuPercent() float64 {
+	if x != nil {
+		return x.TopCpuPercent
+	}
+	return 0
+}
+
+func (x *InterruptDevice) GetImbalanced() bool {
+	if x != nil {
+		return x.Imbalanced
+	}
+	return false
+}
+
+// Значение, полученное от внешнего плагина
+type CustomMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin"`
+	Name   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Value  float64           `protobuf:"fixed64,4,opt,name=value,proto3" json:"value"`
+}
+
+func (x *CustomMetric) Reset() {
+	*x = CustomMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomMetric) ProtoMessage() {}
+
+func (x *CustomMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomMetric.ProtoReflect.Descriptor instead.
+func (*CustomMetric) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CustomMetric) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CustomMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Потребление ресурсов процессами пользователя. cpuPercent - доля одного процессора,
+// listenPorts - порты, на которых процессы пользователя принимают соединения
+type UserUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid         uint32   `protobuf:"varint,1,opt,name=uid,proto3" json:"uid"`
+	User        string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user"`
+	Processes   uint32   `protobuf:"varint,3,opt,name=processes,proto3" json:"processes"`
+	CpuPercent  float64  `protobuf:"fixed64,4,opt,name=cpuPercent,proto3" json:"cpuPercent"`
+	RssBytes    uint64   `protobuf:"varint,5,opt,name=rssBytes,proto3" json:"rssBytes"`
+	Connections uint32   `protobuf:"varint,6,opt,name=connections,proto3" json:"connections"`
+	ListenPorts []uint32 `protobuf:"varint,7,rep,packed,name=listenPorts,proto3" json:"listenPorts"`
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserUsage) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UserUsage) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *UserUsage) GetProcesses() uint32 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *UserUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *UserUsage) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *UserUsage) GetConnections() uint32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *UserUsage) GetListenPorts() []uint32 {
+	if x != nil {
+		return x.ListenPorts
+	}
+	return nil
+}
+
+// Память узла NUMA в байтах и скорости счетчиков numastat в страницах в секунду
+type NumaNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node          uint32  `protobuf:"varint,1,opt,name=node,proto3" json:"node"`
+	MemTotal      uint64  `protobuf:"varint,2,opt,name=memTotal,proto3" json:"memTotal"`
+	MemFree       uint64  `protobuf:"varint,3,opt,name=memFree,proto3" json:"memFree"`
+	MemUsed       uint64  `protobuf:"varint,4,opt,name=memUsed,proto3" json:"memUsed"`
+	NumaHit       float64 `protobuf:"fixed64,5,opt,name=numaHit,proto3" json:"numaHit"`
+	NumaMiss      float64 `protobuf:"fixed64,6,opt,name=numaMiss,proto3" json:"numaMiss"`
+	NumaForeign   float64 `protobuf:"fixed64,7,opt,name=numaForeign,proto3" json:"numaForeign"`
+	InterleaveHit float64 `protobuf:"fixed64,8,opt,name=interleaveHit,proto3" json:"interleaveHit"`
+	LocalNode     float64 `protobuf:"fixed64,9,opt,name=localNode,proto3" json:"localNode"`
+	OtherNode     float64 `protobuf:"fixed64,10,opt,name=otherNode,proto3" json:"otherNode"`
+}
+
+func (x *NumaNode) Reset() {
+	*x = NumaNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumaNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumaNode) ProtoMessage() {}
+
+func (x *NumaNode) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumaNode.ProtoReflect.Descriptor instead.
+func (*NumaNode) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NumaNode) GetNode() uint32 {
+	if x != nil {
+		return x.Node
+	}
+	return 0
+}
+
+func (x *NumaNode) GetMemTotal() uint64 {
+	if x != nil {
+		return x.MemTotal
+	}
+	return 0
+}
+
+func (x *NumaNode) GetMemFree() uint64 {
+	if x != nil {
+		return x.MemFree
+	}
+	return 0
+}
+
+func (x *NumaNode) GetMemUsed() uint64 {
+	if x != nil {
+		return x.MemUsed
+	}
+	return 0
+}
+
+func (x *NumaNode) GetNumaHit() float64 {
+	if x != nil {
+		return x.NumaHit
+	}
+	return 0
+}
+
+func (x *NumaNode) GetNumaMiss() float64 {
+	if x != nil {
+		return x.NumaMiss
+	}
+	return 0
+}
+
+func (x *NumaNode) GetNumaForeign() float64 {
+	if x != nil {
+		return x.NumaForeign
+	}
+	return 0
+}
+
+func (x *NumaNode) GetInterleaveHit() float64 {
+	if x != nil {
+		return x.InterleaveHit
+	}
+	return 0
+}
+
+func (x *NumaNode) GetLocalNode() float64 {
+	if x != nil {
+		return x.LocalNode
+	}
+	return 0
+}
+
+func (x *NumaNode) GetOtherNode() float64 {
+	if x != nil {
+		return x.OtherNode
+	}
+	return 0
+}
+
+// Пул больших страниц одного размера, значения в страницах
+type HugePages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes uint64 `protobuf:"varint,1,opt,name=sizeBytes,proto3" json:"sizeBytes"`
+	Total     uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	Free      uint64 `protobuf:"varint,3,opt,name=free,proto3" json:"free"`
+	Reserved  uint64 `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved"`
+	Surplus   uint64 `protobuf:"varint,5,opt,name=surplus,proto3" json:"surplus"`
+}
+
+func (x *HugePages) Reset() {
+	*x = HugePages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HugePages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HugePages) ProtoMessage() {}
+
+func (x *HugePages) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HugePages.ProtoReflect.Descriptor instead.
+func (*HugePages) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HugePages) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *HugePages) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HugePages) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *HugePages) GetReserved() uint64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *HugePages) GetSurplus() uint64 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+type Numa struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes     []*NumaNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	HugePages []*HugePages `protobuf:"bytes,2,rep,name=hugePages,proto3" json:"hugePages"`
+}
+
+func (x *Numa) Reset() {
+	*x = Numa{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Numa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Numa) ProtoMessage() {}
+
+func (x *Numa) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Numa.ProtoReflect.Descriptor instead.
+func (*Numa) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Numa) GetNodes() []*NumaNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Numa) GetHugePages() []*HugePages {
+	if x != nil {
+		return x.HugePages
+	}
+	return nil
+}
+
+// Устройство в составе программного RAID
+type RaidMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device"`
+	Disk   string `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk"`
+	Role   int32  `protobuf:"varint,3,opt,name=role,proto3" json:"role"`
+	// in_sync, faulty, spare, replacement
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state"`
+}
+
+func (x *RaidMember) Reset() {
+	*x = RaidMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaidMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaidMember) ProtoMessage() {}
+
+func (x *RaidMember) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaidMember.ProtoReflect.Descriptor instead.
+func (*RaidMember) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RaidMember) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *RaidMember) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *RaidMember) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *RaidMember) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Программный RAID из /proc/mdstat. Признак degraded означает проблему со здоровьем массива
+type RaidArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	State        string        `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	Level        string        `protobuf:"bytes,3,opt,name=level,proto3" json:"level"`
+	Members      []*RaidMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members"`
+	DisksTotal   int32         `protobuf:"varint,5,opt,name=disksTotal,proto3" json:"disksTotal"`
+	DisksActive  int32         `protobuf:"varint,6,opt,name=disksActive,proto3" json:"disksActive"`
+	Degraded     bool          `protobuf:"varint,7,opt,name=degraded,proto3" json:"degraded"`
+	SyncAction   string        `protobuf:"bytes,8,opt,name=syncAction,proto3" json:"syncAction"`
+	SyncProgress float64       `protobuf:"fixed64,9,opt,name=syncProgress,proto3" json:"syncProgress"`
+	SyncFinish   float64       `protobuf:"fixed64,10,opt,name=syncFinish,proto3" json:"syncFinish"`
+	SyncSpeed    uint64        `protobuf:"varint,11,opt,name=syncSpeed,proto3" json:"syncSpeed"`
+}
+
+func (x *RaidArray) Reset() {
+	*x = RaidArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaidArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaidArray) ProtoMessage() {}
+
+func (x *RaidArray) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaidArray.ProtoReflect.Descriptor instead.
+func (*RaidArray) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RaidArray) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RaidArray) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RaidArray) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *RaidArray) GetMembers() []*RaidMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RaidArray) GetDisksTotal() int32 {
+	if x != nil {
+		return x.DisksTotal
+	}
+	return 0
+}
+
+func (x *RaidArray) GetDisksActive() int32 {
+	if x != nil {
+		return x.DisksActive
+	}
+	return 0
+}
+
+func (x *RaidArray) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *RaidArray) GetSyncAction() string {
+	if x != nil {
+		return x.SyncAction
+	}
+	return ""
+}
+
+func (x *RaidArray) GetSyncProgress() float64 {
+	if x != nil {
+		return x.SyncProgress
+	}
+	return 0
+}
+
+func (x *RaidArray) GetSyncFinish() float64 {
+	if x != nil {
+		return x.SyncFinish
+	}
+	return 0
+}
+
+func (x *RaidArray) GetSyncSpeed() uint64 {
+	if x != nil {
+		return x.SyncSpeed
+	}
+	return 0
+}
+
+// Число записей conntrack протокола в состоянии state
+type ConntrackEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	State    string `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	Count    uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ConntrackEntries) Reset() {
+	*x = ConntrackEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConntrackEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConntrackEntries) ProtoMessage() {}
+
+func (x *ConntrackEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConntrackEntries.ProtoReflect.Descriptor instead.
+func (*ConntrackEntries) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConntrackEntries) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ConntrackEntries) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ConntrackEntries) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Таблица отслеживания соединений. Счетчики ошибок - скорости в секунду
+type Conntrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        uint64              `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Max          uint64              `protobuf:"varint,2,opt,name=max,proto3" json:"max"`
+	FillPercent  float64             `protobuf:"fixed64,3,opt,name=fillPercent,proto3" json:"fillPercent"`
+	InsertFailed float64             `protobuf:"fixed64,4,opt,name=insertFailed,proto3" json:"insertFailed"`
+	Drop         float64             `protobuf:"fixed64,5,opt,name=drop,proto3" json:"drop"`
+	EarlyDrop    float64             `protobuf:"fixed64,6,opt,name=earlyDrop,proto3" json:"earlyDrop"`
+	Invalid      float64             `protobuf:"fixed64,7,opt,name=invalid,proto3" json:"invalid"`
+	Entries      []*ConntrackEntries `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries"`
+}
+
+func (x *Conntrack) Reset() {
+	*x = Conntrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Conntrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conntrack) ProtoMessage() {}
+
+func (x *Conntrack) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conntrack.ProtoReflect.Descriptor instead.
+func (*Conntrack) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Conntrack) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Conntrack) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *Conntrack) GetFillPercent() float64 {
+	if x != nil {
+		return x.FillPercent
+	}
+	return 0
+}
+
+func (x *Conntrack) GetInsertFailed() float64 {
+	if x != nil {
+		return x.InsertFailed
+	}
+	return 0
+}
+
+func (x *Conntrack) GetDrop() float64 {
+	if x != nil {
+		return x.Drop
+	}
+	return 0
+}
+
+func (x *Conntrack) GetEarlyDrop() float64 {
+	if x != nil {
+		return x.EarlyDrop
+	}
+	return 0
+}
+
+func (x *Conntrack) GetInvalid() float64 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *Conntrack) GetEntries() []*ConntrackEntries {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Событие журнала ядра: oom_kill, hung_task, io_error, fs_error, segfault, link_up, link_down.
+// time - Unix-время в миллисекундах, level - уровень syslog
+type KernelEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time"`
+	Seq     uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	Level   int32  `protobuf:"varint,3,opt,name=level,proto3" json:"level"`
+	Type    string `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	Device  string `protobuf:"bytes,5,opt,name=device,proto3" json:"device"`
+	Process string `protobuf:"bytes,6,opt,name=process,proto3" json:"process"`
+	Pid     int32  `protobuf:"varint,7,opt,name=pid,proto3" json:"pid"`
+	Message string `protobuf:"bytes,8,opt,name=message,proto3" json:"message"`
+}
+
+func (x *KernelEvent) Reset() {
+	*x = KernelEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KernelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KernelEvent) ProtoMessage() {}
+
+func (x *KernelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KernelEvent.ProtoReflect.Descriptor instead.
+func (*KernelEvent) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *KernelEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *KernelEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *KernelEvent) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *KernelEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KernelEvent) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *KernelEvent) GetProcess() string {
+	if x != nil {
+		return x.Process
+	}
+	return ""
+}
+
+func (x *KernelEvent) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *KernelEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Оповещение правила из настроек демона. state - pending, firing или resolved, value - последнее
+// значение метрики. Время - Unix-время в миллисекундах, 0 - событие не наступило
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule        string            `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule"`
+	Expr        string            `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr"`
+	Severity    string            `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity"`
+	State       string            `protobuf:"bytes,4,opt,name=state,proto3" json:"state"`
+	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Value       float64           `protobuf:"fixed64,6,opt,name=value,proto3" json:"value"`
+	Threshold   float64           `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold"`
+	ActiveSince int64             `protobuf:"varint,8,opt,name=activeSince,proto3" json:"activeSince"`
+	FiredAt     int64             `protobuf:"varint,9,opt,name=firedAt,proto3" json:"firedAt"`
+	ResolvedAt  int64             `protobuf:"varint,10,opt,name=resolvedAt,proto3" json:"resolvedAt"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Alert) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Alert) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Alert) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Alert) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Alert) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Alert) GetActiveSince() int64 {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return 0
+}
+
+func (x *Alert) GetFiredAt() int64 {
+	if x != nil {
+		return x.FiredAt
+	}
+	return 0
+}
+
+func (x *Alert) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+// Сообщение, содержащее сведения о включенных/отключенных в настройках метриках.
+// Служит для корректного отображения в клиенте состояния той или иной метрики
+type EnabledMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoadAvg             bool `protobuf:"varint,1,opt,name=loadAvg,proto3" json:"loadAvg"`
+	CpuAvg              bool `protobuf:"varint,2,opt,name=cpuAvg,proto3" json:"cpuAvg"`
+	DiskIO              bool `protobuf:"varint,3,opt,name=diskIO,proto3" json:"diskIO"`
+	DiskUsage           bool `protobuf:"varint,4,opt,name=diskUsage,proto3" json:"diskUsage"`
+	NetConnections      bool `protobuf:"varint,5,opt,name=netConnections,proto3" json:"netConnections"`
+	NetConnectionStates bool `protobuf:"varint,6,opt,name=netConnectionStates,proto3" json:"netConnectionStates"`
+	NetTopByProtocol    bool `protobuf:"varint,7,opt,name=netTopByProtocol,proto3" json:"netTopByProtocol"`
+	NetTopByConnection  bool `protobuf:"varint,8,opt,name=netTopByConnection,proto3" json:"netTopByConnection"`
+	NetTopByProcess     bool `protobuf:"varint,9,opt,name=netTopByProcess,proto3" json:"netTopByProcess"`
+	Dns                 bool `protobuf:"varint,10,opt,name=dns,proto3" json:"dns"`
+	Sensors             bool `protobuf:"varint,11,opt,name=sensors,proto3" json:"sensors"`
+	NetStack            bool `protobuf:"varint,12,opt,name=netStack,proto3" json:"netStack"`
+	Interrupts          bool `protobuf:"varint,13,opt,name=interrupts,proto3" json:"interrupts"`
+	Plugins             bool `protobuf:"varint,14,opt,name=plugins,proto3" json:"plugins"`
+	Users               bool `protobuf:"varint,15,opt,name=users,proto3" json:"users"`
+	Numa                bool `protobuf:"varint,16,opt,name=numa,proto3" json:"numa"`
+	Raid                bool `protobuf:"varint,17,opt,name=raid,proto3" json:"raid"`
+	Conntrack           bool `protobuf:"varint,18,opt,name=conntrack,proto3" json:"conntrack"`
+	KernelEvents        bool `protobuf:"varint,19,opt,name=kernelEvents,proto3" json:"kernelEvents"`
+	Alerts              bool `protobuf:"varint,20,opt,name=alerts,proto3" json:"alerts"`
+	Anomalies           bool `protobuf:"varint,21,opt,name=anomalies,proto3" json:"anomalies"`
+}
+
+func (x *EnabledMetrics) Reset() {
+	*x = EnabledMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnabledMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnabledMetrics) ProtoMessage() {}
+
+func (x *EnabledMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnabledMetrics.ProtoReflect.Descriptor instead.
+func (*EnabledMetrics) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EnabledMetrics) GetLoadAvg() bool {
+	if x != nil {
+		return x.LoadAvg
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetCpuAvg() bool {
+	if x != nil {
+		return x.CpuAvg
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetDiskIO() bool {
+	if x != nil {
+		return x.DiskIO
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetDiskUsage() bool {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetConnections() bool {
+	if x != nil {
+		return x.NetConnections
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetConnectionStates() bool {
+	if x != nil {
+		return x.NetConnectionStates
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetTopByProtocol() bool {
+	if x != nil {
+		return x.NetTopByProtocol
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetTopByConnection() bool {
+	if x != nil {
+		return x.NetTopByConnection
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetTopByProcess() bool {
+	if x != nil {
+		return x.NetTopByProcess
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetDns() bool {
+	if x != nil {
+		return x.Dns
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetSensors() bool {
+	if x != nil {
+		return x.Sensors
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNetStack() bool {
+	if x != nil {
+		return x.NetStack
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetInterrupts() bool {
+	if x != nil {
+		return x.Interrupts
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetPlugins() bool {
+	if x != nil {
+		return x.Plugins
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetUsers() bool {
+	if x != nil {
+		return x.Users
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetNuma() bool {
+	if x != nil {
+		return x.Numa
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetRaid() bool {
+	if x != nil {
+		return x.Raid
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetConntrack() bool {
+	if x != nil {
+		return x.Conntrack
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetKernelEvents() bool {
+	if x != nil {
+		return x.KernelEvents
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetAlerts() bool {
+	if x != nil {
+		return x.Alerts
+	}
+	return false
+}
+
+func (x *EnabledMetrics) GetAnomalies() bool {
+	if x != nil {
+		return x.Anomalies
+	}
+	return false
+}
+
+// Снимок метрик
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics              *EnabledMetrics        `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics"`
+	LoadAvg              *LoadAverage           `protobuf:"bytes,2,opt,name=loadAvg,proto3" json:"loadAvg"`
+	CpuAvg               *CpuAverage            `protobuf:"bytes,3,opt,name=cpuAvg,proto3" json:"cpuAvg"`
+	DiskUsage            []*DiskUsage           `protobuf:"bytes,4,rep,name=diskUsage,proto3" json:"diskUsage"`
+	DiskIO               []*DiskIO              `protobuf:"bytes,5,rep,name=diskIO,proto3" json:"diskIO"`
+	NetConnections       []*NetConnection       `protobuf:"bytes,6,rep,name=netConnections,proto3" json:"netConnections"`
+	NetConnectionsStates []*NetConnectionStates `protobuf:"bytes,7,rep,name=netConnectionsStates,proto3" json:"netConnectionsStates"`
+	NetTopByProtocol     []*NetTopByProtocol    `protobuf:"bytes,8,rep,name=netTopByProtocol,proto3" json:"netTopByProtocol"`
+	NetTopByConnection   []*NetTopByConnection  `protobuf:"bytes,9,rep,name=netTopByConnection,proto3" json:"netTopByConnection"`
+	NetTopByProcess      []*NetTopByProcess     `protobuf:"bytes,10,rep,name=netTopByProcess,proto3" json:"netTopByProcess"`
+	NetTopByApplication  []*NetTopByApplication `protobuf:"bytes,11,rep,name=netTopByApplication,proto3" json:"netTopByApplication"`
+	Dns                  *DnsStat               `protobuf:"bytes,12,opt,name=dns,proto3" json:"dns"`
+	Sensors              []*Sensor              `protobuf:"bytes,13,rep,name=sensors,proto3" json:"sensors"`
+	NetStack             *NetStack              `protobuf:"bytes,14,opt,name=netStack,proto3" json:"netStack"`
+	Interrupts           []*Interrupt           `protobuf:"bytes,15,rep,name=interrupts,proto3" json:"interrupts"`
+	CustomMetrics        []*CustomMetric        `protobuf:"bytes,16,rep,name=customMetrics,proto3" json:"customMetrics"`
+	Users                []*UserUsage           `protobuf:"bytes,17,rep,name=users,proto3" json:"users"`
+	Numa                 *Numa                  `protobuf:"bytes,18,opt,name=numa,proto3" json:"numa"`
+	Raid                 []*RaidArray           `protobuf:"bytes,19,rep,name=raid,proto3" json:"raid"`
+	Conntrack            *Conntrack             `protobuf:"bytes,20,opt,name=conntrack,proto3" json:"conntrack"`
+	// События журнала ядра, появившиеся с предыдущего снимка
+	KernelEvents []*KernelEvent `protobuf:"bytes,21,rep,name=kernelEvents,proto3" json:"kernelEvents"`
+	// Границы окна, по которому построен снимок, - Unix-время в миллисекундах. Длительность окна
+	// измеряется по монотонным часам и не зависит от перевода системного времени
+	WindowStart int64 `protobuf:"varint,22,opt,name=windowStart,proto3" json:"windowStart"`
+	WindowEnd   int64 `protobuf:"varint,23,opt,name=windowEnd,proto3" json:"windowEnd"`
+	// Номер снимка в потоке, начиная с 1. Пропуск номера означает потерянный снимок
+	Seq uint64 `protobuf:"varint,24,opt,name=seq,proto3" json:"seq"`
+	// Идентификатор узла, на котором работает демон
+	Host string `protobuf:"bytes,25,opt,name=host,proto3" json:"host"`
+	// Количество измерений каждого сборщика в окне снимка. Ключ - имя сборщика
+	Samples map[string]uint32 `protobuf:"bytes,26,rep,name=samples,proto3" json:"samples" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Изменения списков относительно предыдущего сообщения. Если поле заполнено, списки
+	// netConnections, diskUsage и diskIO не передаются. Пустое поле означает полный снимок
+	Delta *SnapshotDelta `protobuf:"bytes,27,opt,name=delta,proto3" json:"delta"`
+	// Активные оповещения и оповещения, разрешенные с предыдущего снимка
+	Alerts []*Alert `protobuf:"bytes,28,rep,name=alerts,proto3" json:"alerts"`
+	// Прерывания по устройствам, дисбаланс оценивается по устройству, а не по отдельной линии
+	InterruptDevices []*InterruptDevice `protobuf:"bytes,29,rep,name=interruptDevices,proto3" json:"interruptDevices"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Snapshot) GetMetrics() *EnabledMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Snapshot) GetLoadAvg() *LoadAverage {
+	if x != nil {
+		return x.LoadAvg
+	}
+	return nil
+}
+
+func (x *Snapshot) GetCpuAvg() *CpuAverage {
+	if x != nil {
+		return x.CpuAvg
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDiskUsage() []*DiskUsage {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDiskIO() []*DiskIO {
+	if x != nil {
+		return x.DiskIO
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetConnections() []*NetConnection {
+	if x != nil {
+		return x.NetConnections
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetConnectionsStates() []*NetConnectionStates {
+	if x != nil {
+		return x.NetConnectionsStates
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetTopByProtocol() []*NetTopByProtocol {
+	if x != nil {
+		return x.NetTopByProtocol
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetTopByConnection() []*NetTopByConnection {
+	if x != nil {
+		return x.NetTopByConnection
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetTopByProcess() []*NetTopByProcess {
+	if x != nil {
+		return x.NetTopByProcess
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetTopByApplication() []*NetTopByApplication {
+	if x != nil {
+		return x.NetTopByApplication
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDns() *DnsStat {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSensors() []*Sensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetStack() *NetStack {
+	if x != nil {
+		return x.NetStack
+	}
+	return nil
+}
+
+func (x *Snapshot) GetInterrupts() []*Interrupt {
+	if x != nil {
+		return x.Interrupts
+	}
+	return nil
+}
+
+func (x *Snapshot) GetCustomMetrics() []*CustomMetric {
+	if x != nil {
+		return x.CustomMetrics
+	}
+	return nil
+}
+
+func (x *Snapshot) GetUsers() []*UserUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNuma() *Numa {
+	if x != nil {
+		return x.Numa
+	}
+	return nil
+}
+
+func (x *Snapshot) GetRaid() []*RaidArray {
+	if x != nil {
+		return x.Raid
+	}
+	return nil
+}
+
+func (x *Snapshot) GetConntrack() *Conntrack {
+	if x != nil {
+		return x.Conntrack
+	}
+	return nil
+}
+
+func (x *Snapshot) GetKernelEvents() []*KernelEvent {
+	if x != nil {
+		return x.KernelEvents
+	}
+	return nil
+}
+
+func (x *Snapshot) GetWindowStart() int64 {
+	if x != nil {
+		return x.WindowStart
+	}
+	return 0
+}
+
+func (x *Snapshot) GetWindowEnd() int64 {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return 0
+}
+
+func (x *Snapshot) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Snapshot) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSamples() map[string]uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDelta() *SnapshotDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *Snapshot) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *Snapshot) GetInterruptDevices() []*InterruptDevice {
+	if x != nil {
+		return x.InterruptDevices
+	}
+	return nil
+}
+
+// Запись снимка в файле записи потока. Файл содержит последовательность записей, каждой
+// из которых предшествует ее длина в формате varint. time - Unix-время получения снимка в миллисекундах
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time     int64     `protobuf:"varint,1,opt,name=time,proto3" json:"time"`
+	Snapshot *Snapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Record) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Record) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Изменения списка сетевых соединений. Ключ - socketId
+type NetConnectionsDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   []*NetConnection `protobuf:"bytes,1,rep,name=added,proto3" json:"added"`
+	Changed []*NetConnection `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed"`
+	Removed []string         `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed"`
+	// Порядок ключей, если он отличается от порядка после применения изменений (новые элементы в конце)
+	Order []string `protobuf:"bytes,4,rep,name=order,proto3" json:"order"`
+}
+
+func (x *NetConnectionsDelta) Reset() {
+	*x = NetConnectionsDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetConnectionsDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetConnectionsDelta) ProtoMessage() {}
+
+func (x *NetConnectionsDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetConnectionsDelta.ProtoReflect.Descriptor instead.
+func (*NetConnectionsDelta) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NetConnectionsDelta) GetAdded() []*NetConnection {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *NetConnectionsDelta) GetChanged() []*NetConnection {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *NetConnectionsDelta) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *NetConnectionsDelta) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Изменения списка файловых систем. Ключ - mountPoint
+type DiskUsageDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   []*DiskUsage `protobuf:"bytes,1,rep,name=added,proto3" json:"added"`
+	Changed []*DiskUsage `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed"`
+	Removed []string     `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed"`
+	// Порядок ключей, если он отличается от порядка после применения изменений (новые элементы в конце)
+	Order []string `protobuf:"bytes,4,rep,name=order,proto3" json:"order"`
+}
+
+func (x *DiskUsageDelta) Reset() {
+	*x = DiskUsageDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskUsageDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsageDelta) ProtoMessage() {}
+
+func (x *DiskUsageDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsageDelta.ProtoReflect.Descriptor instead.
+func (*DiskUsageDelta) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DiskUsageDelta) GetAdded() []*DiskUsage {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DiskUsageDelta) GetChanged() []*DiskUsage {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *DiskUsageDelta) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *DiskUsageDelta) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Изменения списка дисков. Ключ - name
+type DiskIODelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   []*DiskIO `protobuf:"bytes,1,rep,name=added,proto3" json:"added"`
+	Changed []*DiskIO `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed"`
+	Removed []string  `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed"`
+	// Порядок ключей, если он отличается от порядка после применения изменений (новые элементы в конце)
+	Order []string `protobuf:"bytes,4,rep,name=order,proto3" json:"order"`
+}
+
+func (x *DiskIODelta) Reset() {
+	*x = DiskIODelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskIODelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIODelta) ProtoMessage() {}
+
+func (x *DiskIODelta) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIODelta.ProtoReflect.Descriptor instead.
+func (*DiskIODelta) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DiskIODelta) GetAdded() []*DiskIO {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DiskIODelta) GetChanged() []*DiskIO {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *DiskIODelta) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *DiskIODelta) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Изменения списков снимка. Пустое поле означает, что метрика отключена и список пуст
+type SnapshotDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetConnections *NetConnectionsDelta `protobuf:"bytes,1,opt,name=netConnections,proto3" json:"netConnections"`
+	DiskUsage      *DiskUsageDelta      `protobuf:"bytes,2,opt,name=diskUsage,proto3" json:"diskUsage"`
+	DiskIO         *DiskIODelta         `protobuf:"bytes,3,opt,name=diskIO,proto3" json:"diskIO"`
+}
+
+func (x *SnapshotDelta) Reset() {
+	*x = SnapshotDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simda_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDelta) ProtoMessage() {}
+
+func (x *SnapshotDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_simda_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDelta.ProtoReflect.Descriptor instead.
+func (*SnapshotDelta) Descriptor() ([]byte, []int) {
+	return file_simda_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SnapshotDelta) GetNetConnections() *NetConnectionsDelta {
+	if x != nil {
+		return x.NetConnections
+	}
+	return nil
+}
+
+func (x *SnapshotDelta) GetDiskUsage() *DiskUsageDelta {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return nil
+}
+
+func (x *SnapshotDelta) GetDiskIO() *DiskIODelta {
+	if x != nil {
+		return x.DiskIO
+	}
+	return nil
+}
+
+var File_simda_proto protoreflect.FileDescriptor
+
+var file_simda_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x64, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xa5, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x5b, 0x0a,
+	0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x37, 0xba, 0x48, 0x34, 0x92, 0x01, 0x31, 0x22, 0x2f, 0x72, 0x2d, 0x52,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x52,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x03, 0x70, 0x35, 0x30, 0x52, 0x03, 0x70, 0x39, 0x35, 0x52,
+	0x03, 0x70, 0x39, 0x39, 0x52, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x52, 0x0c, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x27, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x3a, 0xb3, 0x01, 0xba, 0x48, 0xaf, 0x01, 0x1a, 0x5a, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x57, 0x61, 0x72,
+	0x6d, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65,
+	0x61, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x6e,
+	0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x1a, 0x1b, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x77, 0x61,
+	0x72, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x3e, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x1a, 0x51, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x57, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x72,
+	0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x31, 0x32, 0x30, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x1a, 0x13, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x77, 0x61, 0x72, 0x6d, 0x69, 0x6e, 0x67,
+	0x20, 0x3c, 0x3d, 0x20, 0x31, 0x32, 0x30, 0x22, 0x93, 0x02, 0x0a, 0x0a, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x03, 0x70, 0x35, 0x30, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x70, 0x39, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x03, 0x70, 0x39,
+	0x35, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x70, 0x39, 0x39, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x06, 0x52, 0x03, 0x70, 0x39, 0x39, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x06, 0x73,
+	0x74, 0x64, 0x64, 0x65, 0x76, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x61,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61,
+	0x78, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70,
+	0x35, 0x30, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x39, 0x35, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70,
+	0x39, 0x39, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x22, 0x74, 0x0a,
+	0x0c, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x6f, 0x75, 0x73, 0x22, 0xfd, 0x02, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x66,
+	0x74, 0x65, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x69, 0x66, 0x74,
+	0x65, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x61, 0x73, 0x74, 0x50, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61,
+	0x73, 0x74, 0x50, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x6f, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x66, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x66, 0x69, 0x66, 0x74, 0x65,
+	0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x0c, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x69, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x07, 0x53, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xef, 0x03, 0x0a, 0x0a, 0x43, 0x70, 0x75, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x63, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x63, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x08, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71,
+	0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0b,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x61,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x06, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x74, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x64, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x2e, 0x0a,
+	0x08, 0x74, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x08, 0x74, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a,
+	0x0c, 0x72, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0c, 0x72, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x0c, 0x77, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x32, 0x0a,
+	0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65,
+	0x73, 0x22, 0x97, 0x04, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x15, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12,
+	0x32, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x46, 0x75, 0x6c,
+	0x6c, 0x53, 0x65, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x6b, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x65, 0x63,
+	0x22, 0x35, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6d, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6d, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x2e, 0x0a, 0x08, 0x53, 0x6f, 0x63, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x48, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x37,
+	0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x41, 0x64, 0x64, 0x72, 0x48, 0x02, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0x41, 0x0a, 0x13, 0x4e,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd2,
+	0x01, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x3e, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x10, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x4e, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a,
+	0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0xa5, 0x02, 0x0a, 0x0f, 0x4e,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x12, 0x72, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x12, 0x72, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42,
+	0x0a, 0x12, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x12,
+	0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x44, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x44,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x07, 0x44,
+	0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x6e, 0x78, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6e, 0x78, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x66, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x66, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x44, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x6e, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x44, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x73,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x68, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x22, 0x84, 0x06, 0x0a, 0x08, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x53,
+	0x65, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x65,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x53, 0x65, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x63, 0x70,
+	0x4f, 0x75, 0x74, 0x52, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74,
+	0x63, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x63, 0x70,
+	0x45, 0x73, 0x74, 0x61, 0x62, 0x52, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x45, 0x73, 0x74, 0x61, 0x62, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x63, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x74, 0x63, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x44, 0x72, 0x6f,
+	0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x64, 0x70, 0x52, 0x63, 0x76, 0x62,
+	0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x75, 0x64, 0x70, 0x52, 0x63, 0x76, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x75, 0x64, 0x70, 0x53, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x53, 0x6e, 0x64,
+	0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x64, 0x70,
+	0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x75, 0x64, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x70, 0x49, 0x6e,
+	0x55, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x63, 0x70, 0x49, 0x6e,
+	0x55, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x4f, 0x72, 0x70, 0x68, 0x61,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x57,
+	0x61, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x63, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x4d, 0x65, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4d, 0x65, 0x6d, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x64, 0x70, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x75, 0x64, 0x70, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x64, 0x70, 0x4d, 0x65, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x75, 0x64, 0x70, 0x4d, 0x65, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x36, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x36, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x64, 0x70, 0x36, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x75, 0x64, 0x70, 0x36, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x09,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70, 0x75, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x43, 0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x43, 0x70, 0x75, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x70,
+	0x43, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x74, 0x6f, 0x70, 0x43, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x22,
+	0xc9, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x72, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x69, 0x72, 0x71, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70, 0x75, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x43, 0x70, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x70, 0x43, 0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x43, 0x70, 0x75, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x43, 0x70, 0x75, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f,
+	0x70, 0x43, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x0c,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x61, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x55, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x61, 0x48, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x61, 0x48, 0x69, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4d, 0x69, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4d, 0x69, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x75, 0x6d, 0x61, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x61, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x69, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x48, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x89, 0x01, 0x0a, 0x09, 0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x04,
+	0x4e, 0x75, 0x6d, 0x61, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d,
+	0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x09,
+	0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0x62, 0x0a,
+	0x0a, 0x52, 0x61, 0x69, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0xd9, 0x02, 0x0a, 0x09, 0x52, 0x61, 0x69, 0x64, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x69, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x79, 0x6e, 0x63, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0x5a, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x09, 0x43, 0x6f,
+	0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x61, 0x72,
+	0x6c, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x61,
+	0x72, 0x6c, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x66, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x05, 0x0a, 0x0e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x76, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x76, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69,
+	0x73, 0x6b, 0x49, 0x4f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b,
+	0x49, 0x4f, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x61,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x75, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x61, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x22,
+	0x0a, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e,
+	0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xde, 0x0b, 0x0a, 0x08, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x43, 0x70, 0x75, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x06, 0x63, 0x70, 0x75, 0x41,
+	0x76, 0x67, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x4f, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x12, 0x3d, 0x0a, 0x0e, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x6e, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x14, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6e,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x10, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x4a, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x54, 0x6f,
+	0x70, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a,
+	0x0f, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x0f, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x4d, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x42, 0x79, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x6e, 0x65, 0x74, 0x54,
+	0x6f, 0x70, 0x42, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x03, 0x64,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x08,
+	0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x52, 0x08, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70,
+	0x74, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x75, 0x6d, 0x61, 0x52, 0x04,
+	0x6e, 0x75, 0x6d, 0x61, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x61, 0x69, 0x64, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x69, 0x64,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x04, 0x72, 0x61, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x0c,
+	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4b, 0x65, 0x72, 0x6e,
+	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x45, 0x6e, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x45, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x1d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x10, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x06, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x2b, 0x0a,
+	0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0e,
+	0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x27,
+	0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x4f, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0e, 0x6e, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x4f, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x4f, 0x32, 0x41,
+	0x0a, 0x05, 0x53, 0x69, 0x6d, 0x64, 0x61, 0x12, 0x38, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_simda_proto_rawDescOnce sync.Once
+	file_simda_proto_rawDescData = file_simda_proto_rawDesc
+)
+
+func file_simda_proto_rawDescGZIP() []byte {
+	file_simda_proto_rawDescOnce.Do(func() {
+		file_simda_proto_rawDescData = protoimpl.X.CompressGZIP(file_simda_proto_rawDescData)
+	})
+	return file_simda_proto_rawDescData
+}
+
+var file_simda_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_simda_proto_goTypes = []interface{}{
+	(*Query)(nil),               // 0: daemon.Query
+	(*Request)(nil),             // 1: daemon.Request
+	(*Aggregates)(nil),          // 2: daemon.Aggregates
+	(*AnomalyScore)(nil),        // 3: daemon.AnomalyScore
+	(*LoadAverage)(nil),         // 4: daemon.LoadAverage
+	(*Softirq)(nil),             // 5: daemon.Softirq
+	(*CpuAverage)(nil),          // 6: daemon.CpuAverage
+	(*DiskIO)(nil),              // 7: daemon.DiskIO
+	(*DiskUsage)(nil),           // 8: daemon.DiskUsage
+	(*DiskForecast)(nil),        // 9: daemon.DiskForecast
+	(*Process)(nil),             // 10: daemon.Process
+	(*SockAddr)(nil),            // 11: daemon.SockAddr
+	(*NetConnection)(nil),       // 12: daemon.NetConnection
+	(*NetConnectionStates)(nil), // 13: daemon.NetConnectionStates
+	(*NetTopByProtocol)(nil),    // 14: daemon.NetTopByProtocol
+	(*NetTopByApplication)(nil), // 15: daemon.NetTopByApplication
+	(*NetTopByConnection)(nil),  // 16: daemon.NetTopByConnection
+	(*NetTopByProcess)(nil),     // 17: daemon.NetTopByProcess
+	(*DnsCounter)(nil),          // 18: daemon.DnsCounter
+	(*DnsResolver)(nil),         // 19: daemon.DnsResolver
+	(*DnsStat)(nil),             // 20: daemon.DnsStat
+	(*Sensor)(nil),              // 21: daemon.Sensor
+	(*NetStack)(nil),            // 22: daemon.NetStack
+	(*Interrupt)(nil),           // 23: daemon.Interrupt
+	(*InterruptDevice)(nil),     // 24: daemon.InterruptDevice
+	(*CustomMetric)(nil),        // 25: daemon.CustomMetric
+	(*UserUsage)(nil),           // 26: daemon.UserUsage
+	(*NumaNode)(nil),            // 27: daemon.NumaNode
+	(*HugePages)(nil),           // 28: daemon.HugePages
+	(*Numa)(nil),                // 29: daemon.Numa
+	(*RaidMember)(nil),          // 30: daemon.RaidMember
+	(*RaidArray)(nil),           // 31: daemon.RaidArray
+	(*ConntrackEntries)(nil),    // 32: daemon.ConntrackEntries
+	(*Conntrack)(nil),           // 33: daemon.Conntrack
+	(*KernelEvent)(nil),         // 34: daemon.KernelEvent
+	(*Alert)(nil),               // 35: daemon.Alert
+	(*EnabledMetrics)(nil),      // 36: daemon.EnabledMetrics
+	(*Snapshot)(nil),            // 37: daemon.Snapshot
+	(*Record)(nil),              // 38: daemon.Record
+	(*NetConnectionsDelta)(nil), // 39: daemon.NetConnectionsDelta
+	(*DiskUsageDelta)(nil),      // 40: daemon.DiskUsageDelta
+	(*DiskIODelta)(nil),         // 41: daemon.DiskIODelta
+	(*SnapshotDelta)(nil),       // 42: daemon.SnapshotDelta
+	nil,                         // 43: daemon.CustomMetric.LabelsEntry
+	nil,                         // 44: daemon.Alert.LabelsEntry
+	nil,                         // 45: daemon.Snapshot.SamplesEntry
+}
+var file_simda_proto_depIdxs = []int32{
+	0,  // 0: daemon.Request.queries:type_name -> daemon.Query
+	2,  // 1: daemon.LoadAverage.oneStats:type_name -> daemon.Aggregates
+	2,  // 2: daemon.LoadAverage.fiveStats:type_name -> daemon.Aggregates
+	2,  // 3: daemon.LoadAverage.fifteenStats:type_name -> daemon.Aggregates
+	3,  // 4: daemon.LoadAverage.anomalies:type_name -> daemon.AnomalyScore
+	5,  // 5: daemon.CpuAverage.softirqs:type_name -> daemon.Softirq
+	2,  // 6: daemon.CpuAverage.userStats:type_name -> daemon.Aggregates
+	2,  // 7: daemon.CpuAverage.systemStats:type_name -> daemon.Aggregates
+	2,  // 8: daemon.CpuAverage.idleStats:type_name -> daemon.Aggregates
+	3,  // 9: daemon.CpuAverage.anomalies:type_name -> daemon.AnomalyScore
+	2,  // 10: daemon.DiskIO.tpsStats:type_name -> daemon.Aggregates
+	2,  // 11: daemon.DiskIO.rdSpeedStats:type_name -> daemon.Aggregates
+	2,  // 12: daemon.DiskIO.wrSpeedStats:type_name -> daemon.Aggregates
+	3,  // 13: daemon.DiskIO.anomalies:type_name -> daemon.AnomalyScore
+	9,  // 14: daemon.DiskUsage.forecasts:type_name -> daemon.DiskForecast
+	10, // 15: daemon.NetConnection.process:type_name -> daemon.Process
+	11, // 16: daemon.NetConnection.localAddr:type_name -> daemon.SockAddr
+	11, // 17: daemon.NetConnection.foreignAddr:type_name -> daemon.SockAddr
+	2,  // 18: daemon.NetTopByProtocol.bytesPerSecStats:type_name -> daemon.Aggregates
+	3,  // 19: daemon.NetTopByProtocol.anomalies:type_name -> daemon.AnomalyScore
+	11, // 20: daemon.NetTopByConnection.sourceAddr:type_name -> daemon.SockAddr
+	11, // 21: daemon.NetTopByConnection.destinationAddr:type_name -> daemon.SockAddr
+	2,  // 22: daemon.NetTopByProcess.rxBytesPerSecStats:type_name -> daemon.Aggregates
+	2,  // 23: daemon.NetTopByProcess.txBytesPerSecStats:type_name -> daemon.Aggregates
+	18, // 24: daemon.DnsStat.topDomains:type_name -> daemon.DnsCounter
+	18, // 25: daemon.DnsStat.queryTypes:type_name -> daemon.DnsCounter
+	18, // 26: daemon.DnsStat.responseCodes:type_name -> daemon.DnsCounter
+	19, // 27: daemon.DnsStat.resolvers:type_name -> daemon.DnsResolver
+	43, // 28: daemon.CustomMetric.labels:type_name -> daemon.CustomMetric.LabelsEntry
+	27, // 29: daemon.Numa.nodes:type_name -> daemon.NumaNode
+	28, // 30: daemon.Numa.hugePages:type_name -> daemon.HugePages
+	30, // 31: daemon.RaidArray.members:type_name -> daemon.RaidMember
+	32, // 32: daemon.Conntrack.entries:type_name -> daemon.ConntrackEntries
+	44, // 33: daemon.Alert.labels:type_name -> daemon.Alert.LabelsEntry
+	36, // 34: daemon.Snapshot.metrics:type_name -> daemon.EnabledMetrics
+	4,  // 35: daemon.Snapshot.loadAvg:type_name -> daemon.LoadAverage
+	6,  // 36: daemon.Snapshot.cpuAvg:type_name -> daemon.CpuAverage
+	8,  // 37: daemon.Snapshot.diskUsage:type_name -> daemon.DiskUsage
+	7,  // 38: daemon.Snapshot.diskIO:type_name -> daemon.DiskIO
+	12, // 39: daemon.Snapshot.netConnections:type_name -> daemon.NetConnection
+	13, // 40: daemon.Snapshot.netConnectionsStates:type_name -> daemon.NetConnectionStates
+	14, // 41: daemon.Snapshot.netTopByProtocol:type_name -> daemon.NetTopByProtocol
+	16, // 42: daemon.Snapshot.netTopByConnection:type_name -> daemon.NetTopByConnection
+	17, // 43: daemon.Snapshot.netTopByProcess:type_name -> daemon.NetTopByProcess
+	15, // 44: daemon.Snapshot.netTopByApplication:type_name -> daemon.NetTopByApplication
+	20, // 45: daemon.Snapshot.dns:type_name -> daemon.DnsStat
+	21, // 46: daemon.Snapshot.sensors:type_name -> daemon.Sensor
+	22, // 47: daemon.Snapshot.netStack:type_name -> daemon.NetStack
+	23, // 48: daemon.Snapshot.interrupts:type_name -> daemon.Interrupt
+	25, // 49: daemon.Snapshot.customMetrics:type_name -> daemon.CustomMetric
+	26, // 50: daemon.Snapshot.users:type_name -> daemon.UserUsage
+	29, // 51: daemon.Snapshot.numa:type_name -> daemon.Numa
+	31, // 52: daemon.Snapshot.raid:type_name -> daemon.RaidArray
+	33, // 53: daemon.Snapshot.conntrack:type_name -> daemon.Conntrack
+	34, // 54: daemon.Snapshot.kernelEvents:type_name -> daemon.KernelEvent
+	45, // 55: daemon.Snapshot.samples:type_name -> daemon.Snapshot.SamplesEntry
+	42, // 56: daemon.Snapshot.delta:type_name -> daemon.SnapshotDelta
+	35, // 57: daemon.Snapshot.alerts:type_name -> daemon.Alert
+	24, // 58: daemon.Snapshot.interruptDevices:type_name -> daemon.InterruptDevice
+	37, // 59: daemon.Record.snapshot:type_name -> daemon.Snapshot
+	12, // 60: daemon.NetConnectionsDelta.added:type_name -> daemon.NetConnection
+	12, // 61: daemon.NetConnectionsDelta.changed:type_name -> daemon.NetConnection
+	8,  // 62: daemon.DiskUsageDelta.added:type_name -> daemon.DiskUsage
+	8,  // 63: daemon.DiskUsageDelta.changed:type_name -> daemon.DiskUsage
+	7,  // 64: daemon.DiskIODelta.added:type_name -> daemon.DiskIO
+	7,  // 65: daemon.DiskIODelta.changed:type_name -> daemon.DiskIO
+	39, // 66: daemon.SnapshotDelta.netConnections:type_name -> daemon.NetConnectionsDelta
+	40, // 67: daemon.SnapshotDelta.diskUsage:type_name -> daemon.DiskUsageDelta
+	41, // 68: daemon.SnapshotDelta.diskIO:type_name -> daemon.DiskIODelta
+	1,  // 69: daemon.Simda.StreamSnapshots:input_type -> daemon.Request
+	37, // 70: daemon.Simda.StreamSnapshots:output_type -> daemon.Snapshot
+	70, // [70:71] is the sub-list for method output_type
+	69, // [69:70] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_simda_proto_init() }
+func file_simda_proto_init() {
+	if File_simda_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_simda_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Query); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnomalyScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadAverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Softirq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuAverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Process); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SockAddr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetConnectionStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetTopByProtocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetTopByApplication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetTopByConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetTopByProcess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsResolver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1219,8 +5374,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadAverage); i {
+		file_simda_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1231,8 +5386,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CpuAverage); i {
+		file_simda_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sensor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1243,8 +5398,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskIO); i {
+		file_simda_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetStack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1255,8 +5410,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskUsage); i {
+		file_simda_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interrupt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,8 +5422,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Process); i {
+		file_simda_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterruptDevice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1279,8 +5434,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SockAddr); i {
+		file_simda_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomMetric); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,8 +5446,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetConnection); i {
+		file_simda_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,8 +5458,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetConnectionStates); i {
+		file_simda_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumaNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,8 +5470,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetTopByProtocol); i {
+		file_simda_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HugePages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,8 +5482,8 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetTopByConnection); i {
+		file_simda_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Numa); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,7 +5494,79 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_simda_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaidMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaidArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConntrackEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Conntrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KernelEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnabledMetrics); i {
 			case 0:
 				return &v.state
@@ -1351,7 +5578,7 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
-		file_simda_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_simda_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Snapshot); i {
 			case 0:
 				return &v.state
@@ -1363,15 +5590,76 @@ func file_simda_proto_init() {
 				return nil
 			}
 		}
+		file_simda_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetConnectionsDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsageDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIODelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simda_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_simda_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_simda_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_simda_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_simda_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
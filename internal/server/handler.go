@@ -3,6 +3,7 @@ package server
 import (
 	"time"
 
+	"github.com/skushnerchuk/simda/internal/query"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
@@ -20,8 +21,12 @@ func (s *SimdaServer) StreamSnapshots(r *pb.Request, srv pb.Simda_StreamSnapshot
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	queries, err := query.New(r.Queries)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
-	err = s.serveClient(r, srv)
+	err = s.serveClient(r, srv, queries)
 	if err != nil {
 		if e, ok := status.FromError(err); ok {
 			s.logger.Error("Error serving client", "error", e.Message(), "code", e.Code())
@@ -33,10 +38,10 @@ func (s *SimdaServer) StreamSnapshots(r *pb.Request, srv pb.Simda_StreamSnapshot
 	return nil
 }
 
-func (s *SimdaServer) serveClient(r *pb.Request, srv pb.Simda_StreamSnapshotsServer) error {
+func (s *SimdaServer) serveClient(r *pb.Request, srv pb.Simda_StreamSnapshotsServer, queries *query.Set) error {
 	p, _ := peer.FromContext(srv.Context())
 
-	la := s.streamSnapshot(r, srv)
+	la := s.streamSnapshot(r, srv, queries)
 
 	for {
 		select {
@@ -59,7 +64,11 @@ func (s *SimdaServer) serveClient(r *pb.Request, srv pb.Simda_StreamSnapshotsSer
 	}
 }
 
-func (s *SimdaServer) streamSnapshot(r *pb.Request, srv pb.Simda_StreamSnapshotsServer) <-chan *pb.Snapshot {
-	streamer := NewSnapshotStreamer(s.serverCtx, srv.Context(), r, s.logger, s.cfg)
+func (s *SimdaServer) streamSnapshot(
+	r *pb.Request, srv pb.Simda_StreamSnapshotsServer, queries *query.Set,
+) <-chan *pb.Snapshot {
+	streamer := NewSnapshotStreamer(
+		s.serverCtx, srv.Context(), r, s.logger, s.cfg, s.shared(), s.alerts, s.anomalies, queries,
+	)
 	return streamer.Stream()
 }
@@ -0,0 +1,20 @@
+package server
+
+// Метрики регистрируются в реестре при импорте пакета.
+import (
+	_ "github.com/skushnerchuk/simda/internal/metrics/conntrack"
+	_ "github.com/skushnerchuk/simda/internal/metrics/cpuavg"
+	_ "github.com/skushnerchuk/simda/internal/metrics/diskio"
+	_ "github.com/skushnerchuk/simda/internal/metrics/diskusage"
+	_ "github.com/skushnerchuk/simda/internal/metrics/interrupts"
+	_ "github.com/skushnerchuk/simda/internal/metrics/kernelevents"
+	_ "github.com/skushnerchuk/simda/internal/metrics/loadavg"
+	_ "github.com/skushnerchuk/simda/internal/metrics/netconn"
+	_ "github.com/skushnerchuk/simda/internal/metrics/netpackets"
+	_ "github.com/skushnerchuk/simda/internal/metrics/netstack"
+	_ "github.com/skushnerchuk/simda/internal/metrics/numa"
+	_ "github.com/skushnerchuk/simda/internal/metrics/plugins"
+	_ "github.com/skushnerchuk/simda/internal/metrics/raid"
+	_ "github.com/skushnerchuk/simda/internal/metrics/sensors"
+	_ "github.com/skushnerchuk/simda/internal/metrics/users"
+)
@@ -5,8 +5,14 @@ import (
 	"net"
 
 	"github.com/bufbuild/protovalidate-go"
+	"github.com/skushnerchuk/simda/internal/alert"
+	"github.com/skushnerchuk/simda/internal/anomaly"
 	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/disk"
+	"github.com/skushnerchuk/simda/internal/disk/forecast"
 	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/metrics"
+	"github.com/skushnerchuk/simda/internal/plugins"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"google.golang.org/grpc"
 )
@@ -17,22 +23,43 @@ type SimdaServer struct {
 	logger    logger.Logger
 	serverCtx context.Context
 	pb.UnimplementedSimdaServer
-	cfg       *config.DaemonConfig
-	validator *protovalidate.Validator
+	cfg         *config.DaemonConfig
+	validator   *protovalidate.Validator
+	diskHistory *forecast.History
+	diskUsage   *disk.UsageFeed
+	plugins     *plugins.Runner
+	alerts      *alert.Engine
+	anomalies   *anomaly.Detector
 }
 
 func NewSimdaServer(c *config.DaemonConfig, l logger.Logger) SimdaServer {
 	v, _ := protovalidate.New()
 	return SimdaServer{
-		address:   c.Host + ":" + c.Port,
-		logger:    l,
-		cfg:       c,
-		validator: v,
+		address:     c.Host + ":" + c.Port,
+		logger:      l,
+		cfg:         c,
+		validator:   v,
+		diskHistory: newDiskHistory(c),
 	}
 }
 
+// shared возвращает данные демона, которые используются потоками снимков.
+func (s *SimdaServer) shared() metrics.Shared {
+	return metrics.Shared{History: s.diskHistory, DiskUsage: s.diskUsage, Plugins: s.plugins}
+}
+
 func (s *SimdaServer) Start(ctx context.Context) error {
 	s.serverCtx = ctx
+	// Общие сборщики запускаются до фоновых потоков и клиентов
+	s.startDiskHistory()
+	s.plugins = plugins.NewRunner(ctx, s.cfg, s.logger)
+	s.plugins.Start()
+	if err := s.startAlerts(); err != nil {
+		return err
+	}
+	if err := s.startAnomaly(); err != nil {
+		return err
+	}
 	listener, err := net.Listen("tcp", s.address)
 	if err != nil {
 		return err
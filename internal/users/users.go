@@ -0,0 +1,133 @@
+package users
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/network"
+	"github.com/skushnerchuk/simda/internal/utils"
+)
+
+// Частота, в которой ядро отдает процессорное время процессов в /proc/<pid>/stat.
+const userHZ = 100
+
+// UserStat - потребление ресурсов процессами одного пользователя. CPUPercent - доля одного процессора,
+// поэтому на многопроцессорной системе может превышать 100.
+type UserStat struct {
+	UID         uint32
+	User        string
+	Processes   int
+	CPUPercent  float64
+	RSSBytes    uint64
+	Connections int
+	ListenPorts []uint16
+}
+
+type Stat []UserStat
+
+func (s Stat) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+type Collector interface {
+	Run() (<-chan Stat, error)
+	Get() (Stat, error)
+}
+
+// process - сведения о процессе: владелец, процессорное время в тиках и резидентная память в байтах.
+type process struct {
+	uid   uint32
+	ticks uint64
+	rss   uint64
+}
+
+type snapshot struct {
+	time      time.Time
+	processes map[int]process
+}
+
+// calcStat агрегирует процессы и сокеты по пользователям. Загрузка процессора считается по приращению
+// времени с предыдущего снимка: для процесса, появившегося за интервал, учитывается все его время.
+// Для первого снимка загрузка нулевая.
+func calcStat(prev, cur *snapshot, sockets []network.Connection) Stat {
+	result := make(map[uint32]*UserStat)
+	get := func(uid uint32) *UserStat {
+		item, ok := result[uid]
+		if !ok {
+			item = &UserStat{UID: uid}
+			result[uid] = item
+		}
+		return item
+	}
+
+	elapsed := 0.0
+	if prev != nil {
+		elapsed = cur.time.Sub(prev.time).Seconds()
+	}
+	for pid, p := range cur.processes {
+		item := get(p.uid)
+		item.Processes++
+		item.RSSBytes += p.rss
+		if elapsed <= 0 {
+			continue
+		}
+		ticks := p.ticks
+		if old, ok := prev.processes[pid]; ok {
+			// pid мог быть переиспользован другим процессом
+			if p.ticks < old.ticks {
+				continue
+			}
+			ticks -= old.ticks
+		}
+		item.CPUPercent += float64(ticks) / userHZ / elapsed * 100.0
+	}
+
+	for _, s := range sockets {
+		item := get(s.UserID)
+		if isListening(s) {
+			if s.LocalAddress != nil && !hasPort(item.ListenPorts, s.LocalAddress.Port) {
+				item.ListenPorts = append(item.ListenPorts, s.LocalAddress.Port)
+			}
+			continue
+		}
+		item.Connections++
+	}
+
+	stat := make(Stat, 0, len(result))
+	for _, item := range result {
+		item.User = utils.GetUsernameByID(strconv.FormatUint(uint64(item.UID), 10))
+		sort.Slice(item.ListenPorts, func(i, j int) bool { return item.ListenPorts[i] < item.ListenPorts[j] })
+		stat = append(stat, *item)
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		if stat[i].CPUPercent != stat[j].CPUPercent {
+			return stat[i].CPUPercent > stat[j].CPUPercent
+		}
+		return stat[i].RSSBytes > stat[j].RSSBytes
+	})
+	return stat
+}
+
+// isListening сообщает, что сокет принимает соединения: TCP в состоянии LISTEN
+// или неподключенный UDP сокет.
+func isListening(s network.Connection) bool {
+	switch s.Protocol {
+	case network.ProtocolTCP, network.ProtocolTCP6:
+		return s.State == "LISTEN"
+	case network.ProtocolUDP, network.ProtocolUDP6:
+		return s.State == "CLOSE" && (s.ForeignAddress == nil || s.ForeignAddress.Port == 0)
+	}
+	return false
+}
+
+func hasPort(ports []uint16, port uint16) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+//go:build linux
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/skushnerchuk/simda/internal/network"
+)
+
+var ErrNoProcesses = errors.New("no processes found")
+
+// Признак потока ядра в поле flags /proc/<pid>/stat.
+const pfKThread = 0x00200000
+
+type LinuxUsersCollector struct {
+	serverCtx context.Context
+	clientCtx context.Context
+	cfg       *config.DaemonConfig
+	l         logger.Logger
+	prev      *snapshot
+}
+
+func NewLinuxUsersCollector(
+	serverCtx, clientCtx context.Context, cfg *config.DaemonConfig, l logger.Logger,
+) *LinuxUsersCollector {
+	return &LinuxUsersCollector{
+		serverCtx: serverCtx,
+		clientCtx: clientCtx,
+		cfg:       cfg,
+		l:         l,
+	}
+}
+
+func (l *LinuxUsersCollector) Run() (<-chan Stat, error) {
+	if _, err := l.Get(); err != nil {
+		l.l.Error("users collector error", "error", err.Error())
+		l.cfg.Metrics.Users = false
+		return nil, err
+	}
+	ch := make(chan Stat)
+	ticker := time.NewTicker(l.cfg.Interval(config.CollectorUsers))
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-l.serverCtx.Done():
+			case <-l.clientCtx.Done():
+				l.l.Debug("users collector stopped")
+				return
+			case <-ticker.C:
+				if !l.cfg.Metrics.Users {
+					continue
+				}
+				stat, err := l.Get()
+				if err != nil {
+					l.l.Error("users collector error", "error", err.Error())
+					l.cfg.Metrics.Users = false
+					return
+				}
+				ch <- stat
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Get возвращает потребление ресурсов по пользователям. Владелец процесса определяется
+// по владельцу каталога /proc/<pid>, потоки ядра не учитываются.
+func (l *LinuxUsersCollector) Get() (Stat, error) {
+	processes, err := readProcesses(l.cfg.System.Proc)
+	if err != nil {
+		return nil, err
+	}
+	cur := &snapshot{time: time.Now(), processes: processes}
+	stat := calcStat(l.prev, cur, l.sockets())
+	l.prev = cur
+	return stat, nil
+}
+
+// sockets читает таблицы сокетов. Отсутствующие таблицы (например, при отключенном IPv6) пропускаются.
+func (l *LinuxUsersCollector) sockets() []network.Connection {
+	tables := []struct{ path, protocol string }{
+		{l.cfg.System.TCP, network.ProtocolTCP},
+		{l.cfg.System.TCP6, network.ProtocolTCP6},
+		{l.cfg.System.UDP, network.ProtocolUDP},
+		{l.cfg.System.UDP6, network.ProtocolUDP6},
+	}
+	result := make([]network.Connection, 0)
+	for _, t := range tables {
+		c, err := network.ReadConnections(t.path, t.protocol)
+		if err != nil {
+			l.l.Debug("users collector: socket table skipped", "path", t.path, "error", err.Error())
+			continue
+		}
+		result = append(result, c...)
+	}
+	return result
+}
+
+func readProcesses(procDir string) (map[int]process, error) {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := uint64(os.Getpagesize())
+	result := make(map[int]process)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(procDir, entry.Name())
+		// Процесс мог завершиться во время обхода
+		info, err := os.Stat(dir)
+		if err != nil {
+			continue
+		}
+		sys, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, "stat"))
+		if err != nil {
+			continue
+		}
+		ticks, rssPages, kthread, err := parseProcStat(string(data))
+		if err != nil || kthread {
+			continue
+		}
+		result[pid] = process{uid: sys.Uid, ticks: ticks, rss: rssPages * pageSize}
+	}
+	if len(result) == 0 {
+		return nil, ErrNoProcesses
+	}
+	return result, nil
+}
+
+// parseProcStat возвращает из /proc/<pid>/stat сумму utime и stime, резидентную память в страницах
+// и признак потока ядра. Имя команды может содержать пробелы и скобки, поэтому поля
+// отсчитываются от последней закрывающей скобки.
+func parseProcStat(data string) (ticks, rss uint64, kthread bool, err error) {
+	i := strings.LastIndexByte(data, ')')
+	if i < 0 {
+		return 0, 0, false, fmt.Errorf("invalid process stat: %q", data)
+	}
+	// Поля после имени команды, начиная с состояния (третье поле)
+	fields := strings.Fields(data[i+1:])
+	if len(fields) < 22 {
+		return 0, 0, false, fmt.Errorf("invalid process stat: %q", data)
+	}
+	values := make([]uint64, 0, 4)
+	for _, idx := range []int{6, 11, 12, 21} {
+		v, err := strconv.ParseUint(fields[idx], 10, 64)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("invalid process stat: %w", err)
+		}
+		values = append(values, v)
+	}
+	return values[1] + values[2], values[3], values[0]&pfKThread != 0, nil
+}
@@ -0,0 +1,109 @@
+//go:build linux
+
+package users
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+var log = logger.NewSLogger(os.Stdout, "DEBUG")
+
+const (
+	fakeStat    = "42 (my (weird) cmd) S 1 42 42 0 -1 4194560 100 0 0 0 150 50 0 0 20 0 1 0 100 1000000 25 0 0"
+	fakeKThread = "2 (kthreadd) S 0 0 0 0 -1 2129984 0 0 0 0 0 10 0 0 20 0 1 0 1 0 0 18446744073709551615 0 0"
+	fakeTCP     = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 100 1 0 100 0 0 10 0`
+)
+
+func fakeProc(t *testing.T) *config.DaemonConfig {
+	t.Helper()
+	root := t.TempDir()
+	for pid, stat := range map[string]string{"42": fakeStat, "2": fakeKThread} {
+		require.NoError(t, os.Mkdir(filepath.Join(root, pid), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(root, pid, "stat"), []byte(stat+"\n"), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(root, "tcp"), []byte(fakeTCP+"\n"), 0o600))
+	cfg := &config.DaemonConfig{
+		System: config.SystemPoints{
+			Proc: root,
+			TCP:  filepath.Join(root, "tcp"),
+			TCP6: filepath.Join(root, "tcp6"),
+			UDP:  filepath.Join(root, "udp"),
+			UDP6: filepath.Join(root, "udp6"),
+		},
+		Metrics: config.Metrics{Users: true},
+	}
+	return cfg
+}
+
+func TestUsers(t *testing.T) {
+	log.Disable()
+	defer goleak.VerifyNone(t)
+
+	t.Run("users: process stat", func(t *testing.T) {
+		ticks, rss, kthread, err := parseProcStat(fakeStat)
+		require.NoError(t, err)
+		require.Equal(t, uint64(200), ticks)
+		require.Equal(t, uint64(25), rss)
+		require.False(t, kthread)
+
+		_, _, kthread, err = parseProcStat(fakeKThread)
+		require.NoError(t, err)
+		require.True(t, kthread)
+
+		for _, data := range []string{"", "1 (cmd) S 1 2", "1 (cmd) S 1 1 1 0 -1 x 0 0 0 0 a b 0 0 20 0 1 0 1 0 0 0"} {
+			_, _, _, err = parseProcStat(data)
+			require.Error(t, err, data)
+		}
+	})
+
+	t.Run("users: Get()", func(t *testing.T) {
+		cfg := fakeProc(t)
+		v := NewLinuxUsersCollector(context.TODO(), context.TODO(), cfg, log)
+		val, err := v.Get()
+		require.NoError(t, err)
+		uid := uint32(os.Getuid())
+		byUID := make(map[uint32]UserStat)
+		for _, s := range val {
+			byUID[s.UID] = s
+		}
+		// Процесс принадлежит владельцу каталога, поток ядра не учитывается
+		require.Equal(t, 1, byUID[uid].Processes)
+		require.Equal(t, uint64(25*os.Getpagesize()), byUID[uid].RSSBytes)
+		require.Equal(t, []uint16{8080}, byUID[1000].ListenPorts)
+	})
+
+	t.Run("users: Run() error", func(t *testing.T) {
+		cfg := &config.DaemonConfig{System: config.SystemPoints{Proc: t.TempDir()}, Metrics: config.Metrics{Users: true}}
+		v := NewLinuxUsersCollector(context.TODO(), context.TODO(), cfg, log)
+		ch, err := v.Run()
+		require.Nil(t, ch)
+		require.ErrorIs(t, err, ErrNoProcesses)
+		require.False(t, cfg.Metrics.Users)
+	})
+
+	t.Run("users: metric enabled", func(t *testing.T) {
+		cfg := fakeProc(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		v := NewLinuxUsersCollector(ctx, ctx, cfg, log)
+
+		ch, err := v.Run()
+		require.NoError(t, err)
+		val := <-ch
+		require.NotEmpty(t, val)
+		cancel()
+		for range ch {
+		}
+		require.True(t, cfg.Metrics.Users)
+	})
+}
@@ -0,0 +1,68 @@
+package users
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/skushnerchuk/simda/internal/network"
+	"github.com/stretchr/testify/require"
+)
+
+func socket(protocol, state string, uid uint32, local, foreign uint16) network.Connection {
+	return network.Connection{
+		Protocol:       protocol,
+		State:          state,
+		UserID:         uid,
+		LocalAddress:   &network.SockAddr{IP: net.IPv4zero, Port: local},
+		ForeignAddress: &network.SockAddr{IP: net.IPv4zero, Port: foreign},
+	}
+}
+
+func TestCalcStat(t *testing.T) {
+	now := time.Now()
+	prev := &snapshot{time: now, processes: map[int]process{
+		1:  {uid: 0, ticks: 100, rss: 1000},
+		10: {uid: 1000, ticks: 500, rss: 2000},
+		11: {uid: 1000, ticks: 900, rss: 3000},
+	}}
+	cur := &snapshot{time: now.Add(2 * time.Second), processes: map[int]process{
+		1:  {uid: 0, ticks: 110, rss: 1000},
+		10: {uid: 1000, ticks: 700, rss: 2000},
+		// pid переиспользован, время уменьшилось
+		11: {uid: 1000, ticks: 50, rss: 1000},
+		12: {uid: 1000, ticks: 100, rss: 4000},
+	}}
+	sockets := []network.Connection{
+		socket(network.ProtocolTCP, "LISTEN", 0, 22, 0),
+		socket(network.ProtocolTCP6, "LISTEN", 0, 22, 0),
+		socket(network.ProtocolTCP, "ESTABLISHED", 0, 22, 50000),
+		socket(network.ProtocolUDP, "CLOSE", 1000, 5353, 0),
+		socket(network.ProtocolTCP, "ESTABLISHED", 1000, 40000, 443),
+		socket(network.ProtocolTCP, "TIME_WAIT", 1000, 40001, 443),
+		socket(network.ProtocolTCP, "LISTEN", 1000, 8080, 0),
+	}
+
+	stat := calcStat(prev, cur, sockets)
+	require.Len(t, stat, 2)
+
+	require.Equal(t, uint32(1000), stat[0].UID)
+	require.Equal(t, 3, stat[0].Processes)
+	require.Equal(t, uint64(7000), stat[0].RSSBytes)
+	// (200 + 100) тиков за 2 секунды
+	require.InDelta(t, 150.0, stat[0].CPUPercent, 1e-9)
+	require.Equal(t, 2, stat[0].Connections)
+	require.Equal(t, []uint16{5353, 8080}, stat[0].ListenPorts)
+
+	require.Equal(t, uint32(0), stat[1].UID)
+	require.Equal(t, "root", stat[1].User)
+	require.InDelta(t, 5.0, stat[1].CPUPercent, 1e-9)
+	require.Equal(t, 1, stat[1].Connections)
+	require.Equal(t, []uint16{22}, stat[1].ListenPorts)
+
+	// Для первого снимка загрузка процессора не считается
+	stat = calcStat(nil, cur, nil)
+	for _, s := range stat {
+		require.Zero(t, s.CPUPercent)
+	}
+}
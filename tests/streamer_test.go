@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2" //nolint: revive
 	. "github.com/onsi/gomega"    //nolint: revive
 	"github.com/skushnerchuk/simda/internal/config"
+	"github.com/skushnerchuk/simda/internal/delta"
 	pb "github.com/skushnerchuk/simda/internal/server/gen"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -62,6 +63,18 @@ func restoreDaemonConfig() {
 	viper.Set("metrics.net_connections_states", true)
 	viper.Set("metrics.net_top_by_connection", true)
 	viper.Set("metrics.net_top_by_protocol", true)
+	viper.Set("metrics.net_top_by_process", true)
+	viper.Set("metrics.dns", true)
+	viper.Set("metrics.sensors", true)
+	viper.Set("metrics.net_stack", true)
+	viper.Set("metrics.interrupts", true)
+	viper.Set("metrics.plugins", true)
+	viper.Set("metrics.users", true)
+	viper.Set("metrics.numa", true)
+	viper.Set("metrics.raid", true)
+	viper.Set("metrics.conntrack", true)
+	viper.Set("metrics.kernel_events", true)
+	viper.Set("intervals.disk_usage", "1s")
 	_ = viper.WriteConfig()
 }
 
@@ -116,6 +129,14 @@ var _ = Describe("Common", func() {
 		Expect(snapshot.Metrics.NetConnectionStates).Should(BeTrue())
 		Expect(snapshot.Metrics.NetTopByProtocol).Should(BeTrue())
 		Expect(snapshot.Metrics.NetTopByConnection).Should(BeTrue())
+		Expect(snapshot.Metrics.NetTopByProcess).Should(BeTrue())
+		Expect(snapshot.Metrics.Dns).Should(BeTrue())
+		Expect(snapshot.Metrics.Sensors).Should(BeTrue())
+		Expect(snapshot.Metrics.NetStack).Should(BeTrue())
+		Expect(snapshot.Metrics.Interrupts).Should(BeTrue())
+		Expect(snapshot.Metrics.Plugins).Should(BeTrue())
+		Expect(snapshot.Metrics.Users).Should(BeTrue())
+		Expect(snapshot.Metrics.Numa).Should(BeTrue())
 
 		Expect(snapshot.LoadAvg).ToNot(BeNil())
 		Expect(snapshot.CpuAvg).ToNot(BeNil())
@@ -124,7 +145,15 @@ var _ = Describe("Common", func() {
 		Expect(snapshot.NetConnections).ToNot(BeNil())
 		Expect(snapshot.NetConnectionsStates).ToNot(BeNil())
 		Expect(snapshot.NetTopByProtocol).ToNot(BeNil())
+		Expect(snapshot.NetTopByApplication).ToNot(BeNil())
 		Expect(snapshot.NetTopByConnection).ToNot(BeNil())
+		Expect(snapshot.NetTopByProcess).ToNot(BeNil())
+		Expect(snapshot.Dns).ToNot(BeNil())
+		Expect(snapshot.NetStack).ToNot(BeNil())
+		if len(cfg.Plugins) > 0 {
+			Expect(snapshot.CustomMetrics).ToNot(BeNil())
+		}
+		Expect(snapshot.Numa).ToNot(BeNil())
 	})
 })
 
@@ -150,6 +179,8 @@ var _ = Describe("load avg", func() {
 		Expect(snapshot.LoadAvg.One).Should(BeNumerically(">", 0))
 		Expect(snapshot.LoadAvg.Five).Should(BeNumerically(">", 0))
 		Expect(snapshot.LoadAvg.Fifteen).Should(BeNumerically(">", 0))
+		Expect(snapshot.LoadAvg.TotalThreads).Should(BeNumerically(">", 0))
+		Expect(snapshot.LoadAvg.LastPid).Should(BeNumerically(">", 0))
 	})
 
 	It("check runtime on/off", func() {
@@ -191,6 +222,8 @@ var _ = Describe("cpu", func() {
 		Expect(snapshot.CpuAvg.System).Should(BeNumerically(">", 0))
 		Expect(snapshot.CpuAvg.User).Should(BeNumerically(">", 0))
 		Expect(snapshot.CpuAvg.Idle).Should(BeNumerically(">", 0))
+		Expect(snapshot.CpuAvg.ContextSwitches).Should(BeNumerically(">=", 0))
+		Expect(snapshot.CpuAvg.Softirqs).ToNot(BeEmpty())
 	})
 
 	It("check runtime on/off", func() {
@@ -265,6 +298,12 @@ var _ = Describe("disk usage", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(snapshot).ToNot(BeNil())
 		Expect(snapshot.DiskUsage).ToNot(BeNil())
+		for _, d := range snapshot.DiskUsage {
+			Expect(d.FsType).ToNot(BeEmpty())
+			Expect(d.FsType).ToNot(Equal("squashfs"))
+			Expect(d.Total).To(BeNumerically(">=", d.Used))
+			Expect(d.Forecasts).To(HaveLen(2))
+		}
 	})
 
 	It("check runtime on/off", func() {
@@ -428,5 +467,654 @@ var _ = Describe("net top by protocol", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(snapshot).ToNot(BeNil())
 		Expect(snapshot.NetTopByProtocol).To(BeNil())
+		Expect(snapshot.NetTopByApplication).To(BeNil())
+	})
+})
+
+var _ = Describe("net top by process", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetTopByProcess).ToNot(BeNil())
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetTopByProcess).ToNot(BeNil())
+
+		viper.Set("metrics.net_top_by_process", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetTopByProcess).To(BeNil())
+	})
+})
+
+var _ = Describe("dns", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Dns).ToNot(BeNil())
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Dns).ToNot(BeNil())
+
+		viper.Set("metrics.dns", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Dns).To(BeNil())
+	})
+})
+
+var _ = Describe("sensors", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		// Датчиков на хосте может не быть, поэтому проверяем только состояние метрики
+		Expect(snapshot.Metrics.Sensors).To(BeTrue())
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Sensors).To(BeTrue())
+
+		viper.Set("metrics.sensors", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Sensors).To(BeFalse())
+		Expect(snapshot.Sensors).To(BeNil())
+	})
+})
+
+var _ = Describe("net stack", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetStack).ToNot(BeNil())
+		Expect(snapshot.NetStack.SocketsUsed).Should(BeNumerically(">", 0))
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetStack).ToNot(BeNil())
+
+		viper.Set("metrics.net_stack", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.NetStack).To(BeNil())
+	})
+})
+
+var _ = Describe("interrupts", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Interrupts).To(BeTrue())
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Interrupts).To(BeTrue())
+
+		viper.Set("metrics.interrupts", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Interrupts).To(BeFalse())
+		Expect(snapshot.Interrupts).To(BeNil())
+	})
+})
+
+var _ = Describe("plugins", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		// Плагины выключены в поставляемых настройках, образ тестов добавляет плагин uptime
+		if len(cfg.Plugins) == 0 {
+			Skip("no plugins configured")
+		}
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		// Первый снимок может быть отправлен до завершения первого запуска плагина
+		Eventually(func() []*pb.CustomMetric {
+			snapshot, err = streamer.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			return snapshot.CustomMetrics
+		}).WithTimeout(10 * time.Second).ShouldNot(BeEmpty())
+		Expect(snapshot.CustomMetrics[0].Plugin).To(Equal("uptime"))
+		Expect(snapshot.CustomMetrics[0].Name).To(Equal("uptime_seconds"))
+		Expect(snapshot.CustomMetrics[0].Value).Should(BeNumerically(">", 0))
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.CustomMetrics).ToNot(BeNil())
+
+		viper.Set("metrics.plugins", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.CustomMetrics).To(BeNil())
+	})
+})
+
+var _ = Describe("users", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		// Первый снимок может быть отправлен до заполнения буфера
+		Eventually(func() []*pb.UserUsage {
+			snapshot, err = streamer.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			return snapshot.Users
+		}).WithTimeout(10 * time.Second).ShouldNot(BeEmpty())
+		processes := uint32(0)
+		for _, u := range snapshot.Users {
+			Expect(u.User).ToNot(BeEmpty())
+			processes += u.Processes
+		}
+		Expect(processes).Should(BeNumerically(">", 0))
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Users).Should(BeTrue())
+
+		viper.Set("metrics.users", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Users).To(BeNil())
+	})
+})
+
+var _ = Describe("numa", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Numa).ToNot(BeNil())
+		// Ядро без NUMA отдает только пулы больших страниц
+		Eventually(func() int {
+			snapshot, err = streamer.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			return len(snapshot.Numa.Nodes) + len(snapshot.Numa.HugePages)
+		}).WithTimeout(10 * time.Second).Should(BeNumerically(">", 0))
+		for _, node := range snapshot.Numa.Nodes {
+			Expect(node.MemTotal).Should(BeNumerically(">=", node.MemFree))
+		}
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Numa).ToNot(BeNil())
+
+		viper.Set("metrics.numa", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Numa).To(BeNil())
+	})
+})
+
+var _ = Describe("raid", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		// Без драйвера md файл mdstat отсутствует и сборщик отключается
+		if _, err = os.Stat("/proc/mdstat"); err != nil {
+			Skip("software raid is not supported by kernel")
+		}
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Raid).Should(BeTrue())
+		for _, array := range snapshot.Raid {
+			Expect(array.DisksTotal).Should(BeNumerically(">=", array.DisksActive))
+			if array.DisksActive < array.DisksTotal {
+				Expect(array.Degraded).Should(BeTrue())
+			}
+		}
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+
+		viper.Set("metrics.raid", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Raid).Should(BeFalse())
+		Expect(snapshot.Raid).To(BeNil())
+	})
+})
+
+var _ = Describe("conntrack", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		// Без модуля nf_conntrack сборщик отключается
+		if _, err = os.Stat("/proc/sys/net/netfilter/nf_conntrack_count"); err != nil {
+			Skip("nf_conntrack module is not loaded")
+		}
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Conntrack).Should(BeTrue())
+		Expect(snapshot.Conntrack).ToNot(BeNil())
+		Eventually(func() uint64 {
+			snapshot, err = streamer.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			return snapshot.Conntrack.Max
+		}).WithTimeout(10 * time.Second).Should(BeNumerically(">", 0))
+		Expect(snapshot.Conntrack.FillPercent).Should(BeNumerically("<=", 100))
+		Expect(snapshot.Conntrack.InsertFailed).Should(BeNumerically(">=", 0))
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+
+		viper.Set("metrics.conntrack", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.Conntrack).Should(BeFalse())
+		Expect(snapshot.Conntrack).To(BeNil())
+	})
+})
+
+var _ = Describe("kernel events", func() {
+	var (
+		err      error
+		snapshot *pb.Snapshot
+	)
+
+	AfterEach(func() {
+		restoreDaemonConfig()
+	})
+
+	BeforeEach(func() {
+		restoreDaemonConfig()
+	})
+
+	It("check runtime values", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.KernelEvents).Should(BeTrue())
+		// События появляются только при сбоях, поэтому проверяется лишь их структура
+		for _, event := range snapshot.KernelEvents {
+			Expect(event.Type).ToNot(BeEmpty())
+			Expect(event.Time).Should(BeNumerically(">", 0))
+		}
+	})
+
+	It("check runtime on/off", func() {
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+
+		viper.Set("metrics.kernel_events", false)
+		_ = viper.WriteConfig()
+
+		snapshot, err = streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.Metrics.KernelEvents).Should(BeFalse())
+		Expect(snapshot.KernelEvents).To(BeNil())
+	})
+})
+
+var _ = Describe("aggregations", func() {
+	It("check aggregations from config", func() {
+		snapshot, err := streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.Max).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.GetMax()).Should(BeNumerically(">=", snapshot.LoadAvg.OneStats.GetMean()))
+		Expect(snapshot.LoadAvg.OneStats.P99).To(BeNil())
+		Expect(snapshot.CpuAvg.UserStats).ToNot(BeNil())
+	})
+
+	It("check aggregations from request", func() {
+		ctx, cancel := context.WithCancel(clientCtx)
+		defer cancel()
+		stream, err := client.StreamSnapshots(
+			ctx, &pb.Request{Period: receive, Warming: warm, Aggregations: []string{"min", "p99"}},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		snapshot, err := stream.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(snapshot.LoadAvg.OneStats).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.Min).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.P99).ToNot(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.Max).To(BeNil())
+		Expect(snapshot.LoadAvg.OneStats.GetP99()).Should(BeNumerically(">=", snapshot.LoadAvg.OneStats.GetMin()))
+	})
+
+	It("check unknown aggregation", func() {
+		stream, err := client.StreamSnapshots(
+			clientCtx, &pb.Request{Period: receive, Warming: warm, Aggregations: []string{"median"}},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = stream.Recv()
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+// drainStreamer вычитывает снимки, накопившиеся в общем потоке, пока тест работал с отдельным потоком.
+// Накопленный снимок приходит сразу, очередной - только через период.
+func drainStreamer() {
+	for {
+		start := time.Now()
+		_, err := streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		if time.Since(start) > time.Duration(receive)*time.Second/2 {
+			return
+		}
+	}
+}
+
+var _ = Describe("intervals", func() {
+	AfterEach(func() {
+		restoreDaemonConfig()
+		drainStreamer()
+	})
+
+	It("check slow collector", func() {
+		viper.Set("intervals.disk_usage", "3s")
+		_ = viper.WriteConfig()
+		// Демон перечитывает настройки асинхронно
+		time.Sleep(500 * time.Millisecond)
+
+		ctx, cancel := context.WithCancel(clientCtx)
+		defer cancel()
+		start := time.Now()
+		stream, err := client.StreamSnapshots(ctx, &pb.Request{Period: receive, Warming: warm})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Первый снимок ждет первого измерения сборщика с периодом больше окна
+		snapshot, err := stream.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(time.Since(start)).Should(BeNumerically(">=", 2500*time.Millisecond))
+		Expect(snapshot.DiskUsage).ToNot(BeEmpty())
+
+		// Последнее измерение остается в снимке до прихода следующего
+		start = time.Now()
+		snapshot, err = stream.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(time.Since(start)).Should(BeNumerically("<", 2*time.Second))
+		Expect(snapshot.DiskUsage).ToNot(BeEmpty())
+	})
+})
+
+var _ = Describe("snapshot header", func() {
+	It("check window, sequence and samples", func() {
+		first, err := streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+		second, err := streamer.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(first.Seq).Should(BeNumerically(">", 0))
+		Expect(second.Seq).Should(Equal(first.Seq + 1))
+		Expect(second.Host).ToNot(BeEmpty())
+		Expect(second.Host).Should(Equal(first.Host))
+
+		Expect(second.WindowEnd).Should(BeNumerically(">", second.WindowStart))
+		Expect(second.WindowEnd - second.WindowStart).Should(BeNumerically("<=", int64(warm)*1000))
+		Expect(second.WindowEnd).Should(BeNumerically(">", first.WindowEnd))
+
+		Expect(second.Samples).Should(HaveKey("load_avg"))
+		Expect(second.Samples["load_avg"]).Should(BeNumerically(">", 0))
+		Expect(second.Samples).Should(HaveKey("cpu_avg"))
+	})
+})
+
+var _ = Describe("delta stream", func() {
+	AfterEach(func() {
+		drainStreamer()
+	})
+
+	It("check changes and resync", func() {
+		ctx, cancel := context.WithCancel(clientCtx)
+		defer cancel()
+		stream, err := client.StreamSnapshots(
+			ctx, &pb.Request{Period: receive, Warming: warm, Delta: true, Resync: 3},
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		decoder := delta.NewDecoder()
+		for i := 0; i < 4; i++ {
+			snapshot, err := stream.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			// Полный снимок передается первым и затем каждое третье сообщение
+			if i%3 == 0 {
+				Expect(snapshot.Delta).To(BeNil())
+				Expect(snapshot.NetConnections).ToNot(BeEmpty())
+			} else {
+				Expect(snapshot.Delta).ToNot(BeNil())
+				Expect(snapshot.Delta.NetConnections).ToNot(BeNil())
+				Expect(snapshot.NetConnections).To(BeNil())
+			}
+			Expect(decoder.Decode(snapshot)).To(Succeed())
+			Expect(snapshot.NetConnections).ToNot(BeEmpty())
+			Expect(snapshot.DiskUsage).ToNot(BeEmpty())
+		}
+	})
+})
+
+var _ = Describe("queries", func() {
+	AfterEach(func() {
+		drainStreamer()
+	})
+
+	It("check filter, order and limit", func() {
+		ctx, cancel := context.WithCancel(clientCtx)
+		defer cancel()
+		stream, err := client.StreamSnapshots(ctx, &pb.Request{
+			Period:  receive,
+			Warming: warm,
+			Queries: []*pb.Query{
+				{Target: "netConnections", Filter: "state=LISTEN and protocol=tcp", OrderBy: "local_port", Limit: 3},
+				{Target: "diskUsage", Filter: "mount=/"},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		snapshot, err := stream.Recv()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(snapshot.NetConnections).ToNot(BeEmpty())
+		Expect(len(snapshot.NetConnections)).Should(BeNumerically("<=", 3))
+		for i, c := range snapshot.NetConnections {
+			Expect(c.State).Should(Equal("LISTEN"))
+			Expect(c.Protocol).Should(Equal("tcp"))
+			if i > 0 {
+				Expect(c.LocalAddr.Port).Should(BeNumerically(">=", snapshot.NetConnections[i-1].LocalAddr.Port))
+			}
+		}
+		Expect(snapshot.DiskUsage).Should(HaveLen(1))
+		Expect(snapshot.DiskUsage[0].MountPoint).Should(Equal("/"))
+	})
+
+	It("check invalid query", func() {
+		stream, err := client.StreamSnapshots(clientCtx, &pb.Request{
+			Period:  receive,
+			Warming: warm,
+			Queries: []*pb.Query{{Target: "netConnections", Filter: "state>LISTEN"}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = stream.Recv()
+		Expect(err).Should(HaveOccurred())
 	})
 })